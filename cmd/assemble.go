@@ -16,12 +16,11 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 	"os"
 
 	"github.com/interlynk-io/sbomasm/pkg/assemble"
-	"github.com/interlynk-io/sbomasm/pkg/logger"
+	"github.com/interlynk-io/sbomasm/pkg/sign"
 	"github.com/spf13/cobra"
 )
 
@@ -34,38 +33,54 @@ var assembleCmd = &cobra.Command{
 Basic Example:
     $ sbomasm assemble -n "mega-app" -v "1.0.0" -t "application" in-sbom1.json in-sbom2.json
     $ sbomasm assemble -n "mega-app" -v "1.0.0" -t "application" -f -o "mega_app_flat.sbom.json" in-sbom1.json in-sbom2.json
+    $ find . -name "*.cdx.json" | sbomasm assemble -n "mega-app" -v "1.0.0" -t "application" --input-list -
+    $ sbomasm assemble -n "mega-app" -v "1.0.0" -t "application" --input-dir ./sboms --pattern "*.cdx.json" --recursive
+    $ sbomasm assemble -n "mega-app" -v "1.0.0" -t "application" -o final_sbom_cdx.json sboms.zip in-sbom3.json.gz
+    $ sbomasm assemble -n "mega-app" -v "1.0.0" -t "application" --dry-run in-sbom1.json in-sbom2.json
+    $ sbomasm assemble -n "mega-app" -v "1.0.0" -t "application" -s --spdx-format tagvalue -o mega_app.spdx in-sbom1.json in-sbom2.json
+    $ sbomasm assemble -n "mega-app" -v "1.0.0" -t "application" --proto -o mega_app.cdx.pb in-sbom1.json in-sbom2.json
 
 Advanced Example:
 	$ sbomasm generate > config.yaml (edit the config file to add your settings)
 	$ sbomasm assemble -c config.yaml -o final_sbom_cdx.json in-sbom1.json in-sbom2.json
+	$ sbomasm assemble --config sbomasm.yaml (inputs and output listed in the config file)
 	`,
 	SilenceUsage: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		if len(args) == 0 {
-			return fmt.Errorf("please provide at least one sbom file to assemble")
-		}
+		ctx, stop := commandContext(cmd)
+		defer stop()
 
-		debug, _ := cmd.Flags().GetBool("debug")
-		if debug {
-			logger.InitDebugLogger()
-		} else {
-			logger.InitProdLogger()
+		if verify, _ := cmd.Flags().GetString("verify"); verify != "" {
+			return verifySbom(cmd, verify)
 		}
 
-		ctx := logger.WithLogger(context.Background())
-
 		assembleParams, err := extractArgs(cmd, args)
 		if err != nil {
 			return err
 		}
 
+		if len(assembleParams.Input) == 0 && assembleParams.ConfigPath == "" {
+			return fmt.Errorf("please provide at least one sbom file to assemble")
+		}
+
 		assembleParams.Ctx = &ctx
 
 		// Populate the config object
 		config, err := assemble.PopulateConfig(assembleParams)
 		if err != nil {
-			fmt.Println("Error populating config:", err)
+			return err
 		}
+
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		if dryRun {
+			rep, err := assemble.DryRun(config)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintln(os.Stderr, "dry run, no output written")
+			return rep.Summary.Print(os.Stderr)
+		}
+
 		return assemble.Assemble(config)
 	},
 }
@@ -74,26 +89,75 @@ func init() {
 	rootCmd.AddCommand(assembleCmd)
 	assembleCmd.Flags().StringP("output", "o", "", "path to assembled sbom, defaults to stdout")
 	assembleCmd.Flags().StringP("configPath", "c", "", "path to config file")
+	assembleCmd.Flags().String("config", "", "path to config file, flags override its values")
+	assembleCmd.MarkFlagsMutuallyExclusive("configPath", "config")
+	assembleCmd.Flags().String("input-list", "", "path to a file listing input sboms one per line, use - to read from stdin")
+	assembleCmd.Flags().String("input-dir", "", "directory to discover input sboms from")
+	assembleCmd.Flags().String("pattern", "", "glob used to select files in input-dir e.g '*.cdx.json', defaults to known sbom extensions")
+	assembleCmd.Flags().Bool("recursive", false, "discover input sboms in sub directories of input-dir")
+	assembleCmd.Flags().Int64("max-input-size", assemble.DEFAULT_MAX_INPUT_SIZE>>20, "maximum decompressed size in MiB of each gzip or zip input")
 
 	assembleCmd.Flags().StringP("name", "n", "", "name of the assembled sbom")
 	assembleCmd.Flags().StringP("version", "v", "", "version of the assembled sbom")
 	assembleCmd.Flags().StringP("type", "t", "", "product type of the assembled sbom (application, framework, library, container, device, firmware)")
 	assembleCmd.MarkFlagsRequiredTogether("name", "version", "type")
+	assembleCmd.Flags().Bool("validate", false, "validate the assembled sbom against the json schema of the output spec version, it is not written when invalid")
+	assembleCmd.Flags().String("purl", "", "package url of the assembled sbom's primary component e.g 'pkg:generic/acme/mega-app@1.0.0'")
+	assembleCmd.Flags().String("cpe", "", "cpe of the assembled sbom's primary component")
+	assembleCmd.Flags().StringArray("author", []string{}, "author of the assembled sbom e.g 'name <email>', can be repeated")
+	assembleCmd.Flags().String("supplier", "", "supplier of the assembled sbom e.g 'name <email>'")
+	assembleCmd.Flags().String("timestamp", "", "creation time of the assembled sbom in RFC3339, defaults to SOURCE_DATE_EPOCH or the current time")
+	assembleCmd.Flags().Bool("no-timestamp", false, "leave the creation time out of the assembled cyclonedx sbom")
+	assembleCmd.MarkFlagsMutuallyExclusive("timestamp", "no-timestamp")
 
 	assembleCmd.Flags().BoolP("flatMerge", "f", false, "flat merge")
 	assembleCmd.Flags().BoolP("hierMerge", "m", false, "hierarchical merge")
 	assembleCmd.Flags().BoolP("assemblyMerge", "a", false, "assembly merge")
 	assembleCmd.MarkFlagsMutuallyExclusive("flatMerge", "hierMerge", "assemblyMerge")
-
-	assembleCmd.Flags().BoolP("outputSpecCdx", "g", true, "output in cdx format")
-	assembleCmd.Flags().BoolP("outputSpecSpdx", "s", false, "output in spdx format")
+	assembleCmd.Flags().Bool("low-memory", false, "stream components to the output instead of holding all sboms in memory, requires flat merge of cyclonedx json sboms")
+	assembleCmd.Flags().String("dedup-by", "", "identity used to deduplicate components (purl, name-version, cpe, none), defaults to purl falling back to name-version")
+	assembleCmd.Flags().Bool("strict-licenses", false, "fail when a license can not be mapped to an spdx id, such licenses are kept as is by default")
+	assembleCmd.Flags().StringSlice("include-scope", []string{}, "only keep components of these scopes (required, optional, excluded), can be repeated")
+	assembleCmd.Flags().StringSlice("exclude-scope", []string{}, "drop components of these scopes (required, optional, excluded), can be repeated")
+	assembleCmd.Flags().StringSlice("include-type", []string{}, "only keep components of these types e.g 'library', can be repeated")
+	assembleCmd.Flags().StringSlice("exclude-type", []string{}, "drop components of these types e.g 'file', can be repeated")
+	assembleCmd.Flags().Int("min-components", 0, "fail when the assembled sbom has fewer components, counted after deduplication and filtering")
+	assembleCmd.Flags().Bool("fail-on-empty", false, "fail when the assembled sbom has no components")
+
+	assembleCmd.Flags().BoolP("outputSpecCdx", "g", true, "output in cdx format, defaults to the spec of the input sboms")
+	assembleCmd.Flags().BoolP("outputSpecSpdx", "s", false, "output in spdx format, defaults to the spec of the input sboms")
 	assembleCmd.MarkFlagsMutuallyExclusive("outputSpecCdx", "outputSpecSpdx")
 
 	assembleCmd.Flags().StringP("outputSpecVersion", "e", "", "spec version of the output sbom")
 
 	assembleCmd.Flags().BoolP("xml", "x", false, "output in xml format")
 	assembleCmd.Flags().BoolP("json", "j", true, "output in json format")
-	assembleCmd.MarkFlagsMutuallyExclusive("xml", "json")
+	assembleCmd.Flags().Bool("proto", false, "output in cyclonedx protobuf format")
+	assembleCmd.MarkFlagsMutuallyExclusive("xml", "json", "proto")
+	assembleCmd.Flags().String("spdx-format", "json", "file format of spdx output, json or tagvalue")
+	assembleCmd.Flags().StringArray("output-format", []string{}, "write the assembled sbom as <spec>-<format>:<path> e.g 'cdx-json:out.cdx.json' or 'spdx-tagvalue:out.spdx', can be repeated to write several outputs from one merge")
+	assembleCmd.MarkFlagsMutuallyExclusive("output", "output-format")
+
+	assembleCmd.Flags().Bool("dry-run", false, "merge the input sboms in memory and print a summary to stderr, without writing the output")
+	assembleCmd.Flags().String("report", "", "path to write a json report of the merge to")
+	assembleCmd.Flags().String("sign-key", "", "path to a PEM private key, each output file is signed with a detached JWS written next to it as <output>.sig")
+	assembleCmd.Flags().String("verify", "", "path to an sbom to check against its <sbom>.sig signature with the public or private key of --sign-key, nothing is assembled")
+	assembleCmd.Flags().Bool("progress", false, "show the progress of the assembly on stderr, only when it is a terminal")
+}
+
+// verifySbom checks the signature of the sbom at path with the key of
+// --sign-key.
+func verifySbom(cmd *cobra.Command, path string) error {
+	key, _ := cmd.Flags().GetString("sign-key")
+	if key == "" {
+		return fmt.Errorf("--verify requires the key to check the signature with in --sign-key")
+	}
+
+	if err := sign.VerifyFile(path, key); err != nil {
+		return fmt.Errorf("signature of %s is invalid: %w", path, err)
+	}
+	fmt.Fprintf(os.Stderr, "signature of %s is valid\n", path)
+	return nil
 }
 
 func validatePath(path string) error {
@@ -109,6 +173,22 @@ func validatePath(path string) error {
 	return nil
 }
 
+// outputSpecFromFlags returns the output spec requested on the command line,
+// or an empty string when it is left to be derived from the input sboms.
+func outputSpecFromFlags(cmd *cobra.Command) string {
+	if !cmd.Flags().Changed("outputSpecCdx") && !cmd.Flags().Changed("outputSpecSpdx") {
+		return ""
+	}
+
+	cdx, _ := cmd.Flags().GetBool("outputSpecCdx")
+	spdx, _ := cmd.Flags().GetBool("outputSpecSpdx")
+
+	if spdx || !cdx {
+		return "spdx"
+	}
+	return "cyclonedx"
+}
+
 func extractArgs(cmd *cobra.Command, args []string) (*assemble.Params, error) {
 	aParams := assemble.NewParams()
 
@@ -117,6 +197,10 @@ func extractArgs(cmd *cobra.Command, args []string) (*assemble.Params, error) {
 		return nil, err
 	}
 
+	if cmd.Flags().Changed("config") {
+		configPath, _ = cmd.Flags().GetString("config")
+	}
+
 	if configPath != "" {
 		if err := validatePath(configPath); err != nil {
 			return nil, err
@@ -138,6 +222,15 @@ func extractArgs(cmd *cobra.Command, args []string) (*assemble.Params, error) {
 	aParams.Version = version
 	aParams.Type = typeValue
 
+	purl, _ := cmd.Flags().GetString("purl")
+	cpe, _ := cmd.Flags().GetString("cpe")
+
+	aParams.Purl = purl
+	aParams.CPE = cpe
+
+	validate, _ := cmd.Flags().GetBool("validate")
+	aParams.Validate = validate
+
 	flatMerge, _ := cmd.Flags().GetBool("flatMerge")
 	hierMerge, _ := cmd.Flags().GetBool("hierMerge")
 	assemblyMerge, _ := cmd.Flags().GetBool("assemblyMerge")
@@ -152,26 +245,105 @@ func extractArgs(cmd *cobra.Command, args []string) (*assemble.Params, error) {
 	aParams.Xml = xml
 	aParams.Json = json
 
-	if aParams.Xml {
+	proto, _ := cmd.Flags().GetBool("proto")
+	aParams.Proto = proto
+
+	if aParams.Xml || aParams.Proto {
 		aParams.Json = false
 	}
 
+	spdxFormat, _ := cmd.Flags().GetString("spdx-format")
+	aParams.SpdxFormat = spdxFormat
+
+	outputFormats, _ := cmd.Flags().GetStringArray("output-format")
+	aParams.OutputFormats = outputFormats
+
 	specVersion, _ := cmd.Flags().GetString("outputSpecVersion")
 	aParams.OutputSpecVersion = specVersion
 
-	cdx, _ := cmd.Flags().GetBool("outputSpecCdx")
+	dedupBy, _ := cmd.Flags().GetString("dedup-by")
+	aParams.DedupBy = dedupBy
+
+	reportPath, _ := cmd.Flags().GetString("report")
+	aParams.Report = reportPath
+
+	authors, _ := cmd.Flags().GetStringArray("author")
+	aParams.Authors = authors
+
+	supplier, _ := cmd.Flags().GetString("supplier")
+	aParams.Supplier = supplier
+
+	timestamp, _ := cmd.Flags().GetString("timestamp")
+	aParams.Timestamp = timestamp
+
+	noTimestamp, _ := cmd.Flags().GetBool("no-timestamp")
+	aParams.NoTimestamp = noTimestamp
 
-	if cdx {
-		aParams.OutputSpec = "cyclonedx"
-	} else {
-		aParams.OutputSpec = "spdx"
+	lowMemory, _ := cmd.Flags().GetBool("low-memory")
+	aParams.LowMemory = lowMemory
+
+	strictLicenses, _ := cmd.Flags().GetBool("strict-licenses")
+	aParams.StrictLicenses = strictLicenses
+
+	aParams.IncludeScopes, _ = cmd.Flags().GetStringSlice("include-scope")
+	aParams.ExcludeScopes, _ = cmd.Flags().GetStringSlice("exclude-scope")
+	aParams.IncludeTypes, _ = cmd.Flags().GetStringSlice("include-type")
+	aParams.ExcludeTypes, _ = cmd.Flags().GetStringSlice("exclude-type")
+
+	aParams.SignKey, _ = cmd.Flags().GetString("sign-key")
+
+	aParams.MinComponents, _ = cmd.Flags().GetInt("min-components")
+	aParams.FailOnEmpty, _ = cmd.Flags().GetBool("fail-on-empty")
+
+	maxInputSize, _ := cmd.Flags().GetInt64("max-input-size")
+	if maxInputSize <= 0 {
+		return nil, fmt.Errorf("--max-input-size must be greater than 0")
 	}
+	aParams.MaxInputSize = maxInputSize << 20
+
+	aParams.OutputSpec = outputSpecFromFlags(cmd)
 
 	for _, arg := range args {
+		if _, err := os.Stat(arg); err != nil && hasGlobMeta(arg) {
+			files, err := expandGlob(arg)
+			if err != nil {
+				return nil, err
+			}
+			aParams.Input = append(aParams.Input, files...)
+			continue
+		}
+
 		if err := validatePath(arg); err != nil {
 			return nil, err
 		}
 		aParams.Input = append(aParams.Input, arg)
 	}
+
+	inputDir, _ := cmd.Flags().GetString("input-dir")
+	pattern, _ := cmd.Flags().GetString("pattern")
+	recursive, _ := cmd.Flags().GetBool("recursive")
+
+	if inputDir == "" && (pattern != "" || recursive) {
+		return nil, fmt.Errorf("--pattern and --recursive require --input-dir")
+	}
+
+	if inputDir != "" {
+		files, err := discoverInputs(inputDir, pattern, recursive)
+		if err != nil {
+			return nil, err
+		}
+		aParams.Input = append(aParams.Input, files...)
+	}
+
+	inputList, _ := cmd.Flags().GetString("input-list")
+	if inputList != "" {
+		paths, err := readInputList(inputList)
+		if err != nil {
+			return nil, err
+		}
+		aParams.Input = append(aParams.Input, paths...)
+	}
+
+	aParams.Input = uniqPaths(aParams.Input)
 	return aParams, nil
 }
@@ -16,14 +16,15 @@
 package cmd
 
 import (
-	"context"
+	"bufio"
 	"fmt"
+	"io"
 	"os"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/interlynk-io/sbomasm/pkg/assemble"
 	"github.com/interlynk-io/sbomasm/pkg/dt"
-	"github.com/interlynk-io/sbomasm/pkg/logger"
 	"github.com/spf13/cobra"
 )
 
@@ -35,31 +36,41 @@ var dtCmd = &cobra.Command{
 
 Basic Example:
     $ sbomasm dt -u "http://localhost:8080/" -k "odt_gwiwooi29i1N5Hewkkddkkeiwi3ii" -n "mega-app" -v "1.0.0" -t "application" -o finalsbom.json 11903ba9-a585-4dfb-9a0c-f348345a5473 34103ba2-rt63-2fga-3a8b-t625261g6262
+
+Projects by name and version:
+    $ sbomasm dt -u "http://localhost:8080/" -k "odt_gwiwooi29i1N5Hewkkddkkeiwi3ii" -n "mega-app" -v "1.0.0" -t "application" -o finalsbom.json "web-frontend:2.1.0" "payments-api:1.4.2"
+
+Projects by tag, latest version of each project:
+    $ sbomasm dt -u "http://localhost:8080/" -k "odt_gwiwooi29i1N5Hewkkddkkeiwi3ii" -n "mega-app" -v "1.0.0" -t "application" -o finalsbom.json --tag release-2024-q2 --latest-only
+
+Url and api key from the environment, or the key from stdin with --api-key-stdin:
+    $ SBOMASM_DT_URL="http://localhost:8080/" SBOMASM_DT_API_KEY="odt_gwiwooi29i1N5Hewkkddkkeiwi3ii" sbomasm dt -n "mega-app" -v "1.0.0" -t "application" -o finalsbom.json "web-frontend:2.1.0"
+
+Upload the assembled sbom to a new project version:
+    $ sbomasm dt -u "http://localhost:8080/" -k "odt_gwiwooi29i1N5Hewkkddkkeiwi3ii" -n "mega-app" -v "1.0.0" -t "application" --upload-name "mega-app" --upload-version "1.0.0" --wait 11903ba9-a585-4dfb-9a0c-f348345a5473 34103ba2-rt63-2fga-3a8b-t625261g6262
 	`,
 	SilenceUsage: true,
-	RunE: func(cmd *cobra.Command, args []string) error {
-		if len(args) == 0 {
-			return fmt.Errorf("please provide at least one sbom file to assemble")
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		tags, _ := cmd.Flags().GetStringArray("tag")
+		if len(args) == 0 && len(tags) == 0 {
+			return fmt.Errorf("please provide at least one sbom file, project or tag to assemble")
 		}
 
-		debug, _ := cmd.Flags().GetBool("debug")
-		if debug {
-			logger.InitDebugLogger()
-		} else {
-			logger.InitProdLogger()
-		}
-
-		ctx := logger.WithLogger(context.Background())
+		ctx, stop := commandContext(cmd)
+		defer stop()
 
 		dtParams, err := extractDtArgs(cmd, args)
 		if err != nil {
 			return err
 		}
+		defer func() { err = dtParams.RedactError(err) }()
 
 		dtParams.Ctx = &ctx
 
 		// retrieve Input Files
-		dtParams.PopulateInputField(ctx)
+		if err := dtParams.PopulateInputField(ctx); err != nil {
+			return err
+		}
 
 		assembleParams, err := extractArgsFromDTtoAssemble(dtParams)
 		if err != nil {
@@ -69,18 +80,32 @@ Basic Example:
 
 		config, err := assemble.PopulateConfig(assembleParams)
 		if err != nil {
-			fmt.Println("Error populating config:", err)
+			return err
 		}
-		return assemble.Assemble(config)
+
+		if !dtParams.Upload {
+			return assemble.Assemble(config)
+		}
+
+		bom, err := assemble.AssembleBytes(config)
+		if err != nil {
+			return err
+		}
+
+		if dtParams.Output != "" {
+			if err := assemble.WriteFile(dtParams.Output, bom); err != nil {
+				return err
+			}
+		}
+		return dtParams.UploadBom(ctx, bom)
 	},
 }
 
 func extractArgsFromDTtoAssemble(dtParams *dt.Params) (*assemble.Params, error) {
 	aParams := assemble.NewParams()
 
+	// Uploads are done by the dt package once the sbom is assembled
 	aParams.Output = dtParams.Output
-	aParams.Upload = dtParams.Upload
-	aParams.UploadProjectID = dtParams.UploadProjectID
 	aParams.Url = dtParams.Url
 	aParams.ApiKey = dtParams.ApiKey
 
@@ -94,6 +119,7 @@ func extractArgsFromDTtoAssemble(dtParams *dt.Params) (*assemble.Params, error)
 
 	aParams.Xml = dtParams.Xml
 	aParams.Json = dtParams.Json
+	aParams.SpdxFormat = dtParams.SpdxFormat
 
 	aParams.OutputSpecVersion = dtParams.OutputSpecVersion
 
@@ -104,6 +130,20 @@ func extractArgsFromDTtoAssemble(dtParams *dt.Params) (*assemble.Params, error)
 	return aParams, nil
 }
 
+// readApiKey reads the api key from the first line of r.
+func readApiKey(r io.Reader) (string, error) {
+	line, err := bufio.NewReader(r).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return "", fmt.Errorf("unable to read api key from stdin: %w", err)
+	}
+
+	key := strings.TrimSpace(line)
+	if key == "" {
+		return "", fmt.Errorf("no api key read from stdin")
+	}
+	return key, nil
+}
+
 func extractDtArgs(cmd *cobra.Command, args []string) (*dt.Params, error) {
 	aParams := dt.NewParams()
 
@@ -111,14 +151,41 @@ func extractDtArgs(cmd *cobra.Command, args []string) (*dt.Params, error) {
 	if err != nil {
 		return nil, err
 	}
+	if url == "" {
+		url = os.Getenv(dt.ENV_URL)
+	}
+	if url == "" {
+		return nil, fmt.Errorf("dependency track url is required, set --url or %s", dt.ENV_URL)
+	}
 
 	apiKey, err := cmd.Flags().GetString("api-key")
 	if err != nil {
 		return nil, err
 	}
+	if keyStdin, _ := cmd.Flags().GetBool("api-key-stdin"); keyStdin {
+		apiKey, err = readApiKey(os.Stdin)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if apiKey == "" {
+		apiKey = os.Getenv(dt.ENV_API_KEY)
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("dependency track api key is required, set --api-key, --api-key-stdin or %s", dt.ENV_API_KEY)
+	}
 	aParams.Url = url
 	aParams.ApiKey = apiKey
 
+	timeout, _ := cmd.Flags().GetDuration("dt-timeout")
+	retries, _ := cmd.Flags().GetInt("dt-retries")
+	if retries < 0 {
+		return nil, fmt.Errorf("--dt-retries must not be negative")
+	}
+
+	aParams.Timeout = timeout
+	aParams.Retries = retries
+
 	name, _ := cmd.Flags().GetString("name")
 	version, _ := cmd.Flags().GetString("version")
 	typeValue, _ := cmd.Flags().GetString("type")
@@ -145,29 +212,68 @@ func extractDtArgs(cmd *cobra.Command, args []string) (*dt.Params, error) {
 		aParams.Json = false
 	}
 
+	spdxFormat, _ := cmd.Flags().GetString("spdx-format")
+	aParams.SpdxFormat = spdxFormat
+
 	specVersion, _ := cmd.Flags().GetString("outputSpecVersion")
 	aParams.OutputSpecVersion = specVersion
 
-	cdx, _ := cmd.Flags().GetBool("outputSpecCdx")
-
-	if cdx {
-		aParams.OutputSpec = "cyclonedx"
-	} else {
-		aParams.OutputSpec = "spdx"
-	}
+	aParams.OutputSpec = outputSpecFromFlags(cmd)
 
 	output, err := cmd.Flags().GetString("output")
 	if err != nil {
 		return nil, err
 	}
+
+	uploadTo, _ := cmd.Flags().GetString("upload-to")
+	uploadName, _ := cmd.Flags().GetString("upload-name")
+	uploadVersion, _ := cmd.Flags().GetString("upload-version")
+	wait, _ := cmd.Flags().GetBool("wait")
+
 	// Check if the output is a valid UUID, i.e. project ID
 	if _, err := uuid.Parse(output); err == nil {
-		aParams.Upload = true
-		aParams.UploadProjectID = uuid.MustParse(output)
+		if uploadTo != "" || uploadName != "" {
+			return nil, fmt.Errorf("output %s is a project id, it cannot be combined with --upload-to or --upload-name", output)
+		}
+		uploadTo = output
 	} else {
 		// Assume it's a file path
 		aParams.Output = output
-		aParams.Upload = false
+	}
+
+	if uploadTo != "" {
+		pid, err := uuid.Parse(uploadTo)
+		if err != nil {
+			return nil, fmt.Errorf("invalid project id %s for --upload-to: %w", uploadTo, err)
+		}
+		aParams.UploadProjectID = pid
+	}
+
+	aParams.UploadName = uploadName
+	aParams.UploadVersion = uploadVersion
+	aParams.Upload = uploadTo != "" || uploadName != ""
+	aParams.Wait = wait
+
+	if aParams.Wait && !aParams.Upload {
+		return nil, fmt.Errorf("--wait requires --upload-to or --upload-name")
+	}
+
+	// Dependency-Track only accepts CycloneDX sboms
+	if aParams.Upload {
+		if aParams.OutputSpec == "spdx" {
+			return nil, fmt.Errorf("dependency track only accepts cyclonedx sboms, remove --outputSpecSpdx to upload")
+		}
+		aParams.OutputSpec = "cyclonedx"
+	}
+
+	tags, _ := cmd.Flags().GetStringArray("tag")
+	latestOnly, _ := cmd.Flags().GetBool("latest-only")
+
+	aParams.Tags = tags
+	aParams.LatestOnly = latestOnly
+
+	if aParams.LatestOnly && len(aParams.Tags) == 0 {
+		return nil, fmt.Errorf("--latest-only requires at least one --tag")
 	}
 
 	for _, arg := range args {
@@ -180,11 +286,17 @@ func extractDtArgs(cmd *cobra.Command, args []string) (*dt.Params, error) {
 			continue
 		}
 
-		argID, err := uuid.Parse(arg)
-		if err != nil {
-			return nil, err
+		// Project ids are tried first, then name:version specifiers
+		if argID, err := uuid.Parse(arg); err == nil {
+			aParams.ProjectIds = append(aParams.ProjectIds, argID)
+			continue
+		}
+
+		ref, ok := dt.ParseProjectRef(arg)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a file, project id or name:version", arg)
 		}
-		aParams.ProjectIds = append(aParams.ProjectIds, argID)
+		aParams.ProjectRefs = append(aParams.ProjectRefs, ref)
 	}
 	return aParams, nil
 }
@@ -193,12 +305,25 @@ func init() {
 	// Add dt as a sub-command of assemble
 	assembleCmd.AddCommand(dtCmd)
 
-	dtCmd.Flags().StringP("url", "u", "", "dependency track url https://localhost:8080/")
-	dtCmd.Flags().StringP("api-key", "k", "", "dependency track api key, requires VIEW_PORTFOLIO for scoring and PORTFOLIO_MANAGEMENT for tagging")
-	dtCmd.MarkFlagsRequiredTogether("url", "api-key")
+	dtCmd.Flags().StringP("url", "u", "", "dependency track url https://localhost:8080/, defaults to "+dt.ENV_URL)
+	dtCmd.Flags().StringP("api-key", "k", "", "dependency track api key, requires VIEW_PORTFOLIO for scoring and PORTFOLIO_MANAGEMENT for tagging, defaults to "+dt.ENV_API_KEY)
+	dtCmd.Flags().Bool("api-key-stdin", false, "read the dependency track api key from the first line of stdin")
+	dtCmd.MarkFlagsMutuallyExclusive("api-key", "api-key-stdin")
+	dtCmd.Flags().Duration("dt-timeout", dt.DEFAULT_TIMEOUT, "timeout of each dependency track api call")
+	dtCmd.Flags().Int("dt-retries", dt.DEFAULT_RETRIES, "retries of dependency track api calls failing with a server or connection error, with exponential backoff")
+
+	dtCmd.Flags().StringArray("tag", []string{}, "assemble all projects with this tag, can be repeated")
+	dtCmd.Flags().Bool("latest-only", false, "only assemble the latest version of each project found by --tag")
 
 	dtCmd.Flags().StringP("output", "o", "", "path to file or project id for newly assembled sbom, defaults to stdout")
 
+	dtCmd.Flags().String("upload-to", "", "project id to upload the assembled sbom to")
+	dtCmd.Flags().String("upload-name", "", "name of the project to upload the assembled sbom to, created when missing")
+	dtCmd.Flags().String("upload-version", "", "version of the project to upload the assembled sbom to")
+	dtCmd.Flags().Bool("wait", false, "wait for dependency track to process the uploaded sbom")
+	dtCmd.MarkFlagsRequiredTogether("upload-name", "upload-version")
+	dtCmd.MarkFlagsMutuallyExclusive("upload-to", "upload-name")
+
 	dtCmd.Flags().StringP("name", "n", "", "name of the assembled sbom")
 	dtCmd.Flags().StringP("version", "v", "", "version of the assembled sbom")
 	dtCmd.Flags().StringP("type", "t", "", "product type of the assembled sbom (application, framework, library, container, device, firmware)")
@@ -218,4 +343,7 @@ func init() {
 	dtCmd.Flags().BoolP("xml", "x", false, "output in xml format")
 	dtCmd.Flags().BoolP("json", "j", true, "output in json format")
 	dtCmd.MarkFlagsMutuallyExclusive("xml", "json")
+	dtCmd.Flags().String("spdx-format", "json", "file format of spdx output, json or tagvalue")
+
+	dtCmd.Flags().Bool("progress", false, "show the progress of the fetch and assembly on stderr, only when it is a terminal")
 }
@@ -44,13 +44,6 @@ Advanced Example:
 	SilenceUsage: true,
 	Args:         cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		debug, _ := cmd.Flags().GetBool("debug")
-		if debug {
-			logger.InitDebugLogger()
-		} else {
-			logger.InitProdLogger()
-		}
-
 		ctx := logger.WithLogger(context.Background())
 
 		editParams, err := extractEditArgs(cmd, args)
@@ -0,0 +1,230 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// sbomExtensions are the file extensions picked up from an input directory
+// when no pattern is provided.
+var sbomExtensions = []string{".json", ".xml", ".spdx", ".yaml", ".yml", ".rdf", ".gz", ".zip"}
+
+// readInputList reads newline separated sbom paths from the file at path, or
+// from stdin when path is "-". Blank lines and lines starting with # are skipped.
+func readInputList(path string) ([]string, error) {
+	var r io.Reader
+	name := path
+
+	if path == "-" {
+		r = os.Stdin
+		name = "stdin"
+	} else {
+		if err := validatePath(path); err != nil {
+			return nil, err
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	paths := []string{}
+	sc := bufio.NewScanner(r)
+	lineNo := 0
+
+	for sc.Scan() {
+		lineNo++
+
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if err := validatePath(line); err != nil {
+			return nil, fmt.Errorf("input list %s line %d: %w", name, lineNo, err)
+		}
+		paths = append(paths, line)
+	}
+
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("reading input list %s: %w", name, err)
+	}
+
+	return paths, nil
+}
+
+// uniqPaths removes duplicate paths, keeping the first occurrence.
+func uniqPaths(paths []string) []string {
+	seen := map[string]bool{}
+	uniq := []string{}
+
+	for _, p := range paths {
+		key := filepath.Clean(p)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		uniq = append(uniq, p)
+	}
+
+	return uniq
+}
+
+func hasGlobMeta(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
+
+// expandGlob returns the files matching pattern, directories are skipped.
+func expandGlob(pattern string) ([]string, error) {
+	matches, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid glob %s: %w", pattern, err)
+	}
+
+	files := []string{}
+	for _, m := range matches {
+		stat, err := os.Stat(m)
+		if err != nil {
+			return nil, err
+		}
+		if stat.IsDir() {
+			continue
+		}
+		files = append(files, m)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no files match %s", pattern)
+	}
+
+	return files, nil
+}
+
+// discoverInputs walks dir and returns the files whose name matches pattern,
+// or one of the sbomExtensions when pattern is empty. Sub directories are only
+// visited when recursive is set. Symlinks are followed, directories already
+// visited are skipped to guard against symlink cycles.
+func discoverInputs(dir, pattern string, recursive bool) ([]string, error) {
+	if pattern != "" {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %s: %w", pattern, err)
+		}
+	}
+
+	match := func(name string) bool {
+		if pattern != "" {
+			ok, _ := filepath.Match(pattern, name)
+			return ok
+		}
+
+		ext := strings.ToLower(filepath.Ext(name))
+		for _, e := range sbomExtensions {
+			if ext == e {
+				return true
+			}
+		}
+		return false
+	}
+
+	w := &inputWalker{
+		match:     match,
+		recursive: recursive,
+		seenDirs:  map[string]bool{},
+		seenFiles: map[string]bool{},
+	}
+
+	if err := w.walk(dir); err != nil {
+		return nil, err
+	}
+
+	if len(w.files) == 0 {
+		if pattern == "" {
+			pattern = strings.Join(sbomExtensions, ", ")
+		}
+		return nil, fmt.Errorf("no sbom files found in %s matching %s", dir, pattern)
+	}
+
+	return w.files, nil
+}
+
+type inputWalker struct {
+	match     func(string) bool
+	recursive bool
+	seenDirs  map[string]bool
+	seenFiles map[string]bool
+	files     []string
+}
+
+func (w *inputWalker) walk(dir string) error {
+	real, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return err
+	}
+
+	if w.seenDirs[real] {
+		return nil
+	}
+	w.seenDirs[real] = true
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range entries {
+		path := filepath.Join(dir, e.Name())
+
+		stat, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+
+		if stat.IsDir() {
+			if w.recursive {
+				if err := w.walk(path); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		if !w.match(e.Name()) {
+			continue
+		}
+
+		realFile, err := filepath.EvalSymlinks(path)
+		if err != nil {
+			return err
+		}
+
+		if w.seenFiles[realFile] {
+			continue
+		}
+		w.seenFiles[realFile] = true
+		w.files = append(w.files, path)
+	}
+
+	return nil
+}
@@ -19,9 +19,13 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/google/go-github/v52/github"
+	"github.com/interlynk-io/sbomasm/pkg/logger"
+	"github.com/interlynk-io/sbomasm/pkg/progress"
 	"github.com/spf13/cobra"
 	version "sigs.k8s.io/release-utils/version"
 )
@@ -37,13 +41,21 @@ into a final sbom.
 `,
 	// Uncomment the following line if your bare application
 	// has an action associated with it:
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		quiet, err := initLogger(cmd)
+		if err != nil {
+			return err
+		}
+		if !quiet {
+			checkIfLatestRelease()
+		}
+		return nil
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	checkIfLatestRelease()
-
 	err := rootCmd.Execute()
 	if err != nil {
 		os.Exit(1)
@@ -61,6 +73,40 @@ func init() {
 	// when this action is called directly.
 	rootCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 	rootCmd.PersistentFlags().BoolP("debug", "d", false, "debug output")
+	rootCmd.PersistentFlags().BoolP("quiet", "q", false, "only log errors")
+}
+
+// initLogger sets up the logger from the debug and quiet flags and reports
+// whether it is quiet. Logs are written to stderr, so that stdout only holds
+// the sbom when no output file is set.
+func initLogger(cmd *cobra.Command) (bool, error) {
+	debug, _ := cmd.Flags().GetBool("debug")
+	quiet, _ := cmd.Flags().GetBool("quiet")
+
+	switch {
+	case debug && quiet:
+		return false, fmt.Errorf("--debug and --quiet can not be combined")
+	case debug:
+		logger.InitDebugLogger()
+	case quiet:
+		logger.InitQuietLogger()
+	default:
+		logger.InitProdLogger()
+	}
+	return quiet, nil
+}
+
+// commandContext returns the context of a command, it is canceled on an
+// interrupt so that the command stops before writing any output. A progress
+// reporter is added when --progress is set and stderr is a terminal.
+func commandContext(cmd *cobra.Command) (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(logger.WithLogger(context.Background()), os.Interrupt, syscall.SIGTERM)
+
+	show, _ := cmd.Flags().GetBool("progress")
+	if show && progress.IsTerminal(os.Stderr) {
+		ctx = progress.WithReporter(ctx, progress.New(os.Stderr))
+	}
+	return ctx, stop
 }
 
 func checkIfLatestRelease() {
@@ -71,7 +117,7 @@ func checkIfLatestRelease() {
 	client := github.NewClient(nil)
 	rr, resp, err := client.Repositories.GetLatestRelease(context.Background(), "interlynk-io", "sbomasm")
 	if err != nil {
-		panic(err)
+		return
 	}
 
 	if resp.StatusCode != 200 {
@@ -90,6 +136,6 @@ func checkIfLatestRelease() {
 
 	result := verInstalled.Compare(verLatest)
 	if result < 0 {
-		fmt.Printf("\nA new version of sbomasm is available %s.\n\n", rr.GetTagName())
+		fmt.Fprintf(os.Stderr, "\nA new version of sbomasm is available %s.\n\n", rr.GetTagName())
 	}
 }
@@ -0,0 +1,238 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assemble
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// DEFAULT_MAX_INPUT_SIZE caps the decompressed size of each compressed input.
+const DEFAULT_MAX_INPUT_SIZE int64 = 512 << 20
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zipMagic  = []byte("PK\x03\x04")
+)
+
+// maximum depth of archives nested in each other, e.g a .json.gz in a .zip
+const maxArchiveDepth = 3
+
+// archiveExpander extracts compressed inputs into a temporary directory, which
+// is created on first use.
+type archiveExpander struct {
+	maxSize int64
+	dir     string
+	count   int
+
+	// names maps the extracted files to their location in the inputs,
+	// e.g sboms.zip!app.cdx.json
+	names map[string]string
+}
+
+func archiveKind(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	magic := make([]byte, 4)
+	n, err := io.ReadFull(f, magic)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		return "", err
+	}
+	magic = magic[:n]
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		return "gzip", nil
+	case bytes.HasPrefix(magic, zipMagic):
+		return "zip", nil
+	}
+	return "", nil
+}
+
+// expand returns the inputs with every gzip file replaced by its decompressed
+// content and every zip archive replaced by the sboms it contains. Other files
+// are returned as is.
+func (e *archiveExpander) expand(files []string) ([]string, error) {
+	expanded := []string{}
+
+	for _, f := range files {
+		paths, err := e.expandFile(f, f, 0)
+		if err != nil {
+			return nil, err
+		}
+		expanded = append(expanded, paths...)
+	}
+
+	return expanded, nil
+}
+
+func (e *archiveExpander) expandFile(path, name string, depth int) ([]string, error) {
+	kind, err := archiveKind(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if kind != "" && depth >= maxArchiveDepth {
+		return nil, fmt.Errorf("%s is nested more than %d archives deep", name, maxArchiveDepth)
+	}
+
+	switch kind {
+	case "gzip":
+		return e.expandGzip(path, name, depth)
+	case "zip":
+		return e.expandZip(path, name, depth)
+	}
+
+	if depth > 0 {
+		if e.names == nil {
+			e.names = make(map[string]string)
+		}
+		e.names[path] = name
+	}
+	return []string{path}, nil
+}
+
+func (e *archiveExpander) expandGzip(path, name string, depth int) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	zr, err := gzip.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return nil, fmt.Errorf("reading gzip %s: %w", name, err)
+	}
+	defer zr.Close()
+
+	out, err := e.extract(zr, strings.TrimSuffix(name, ".gz"), e.maxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.expandFile(out, name, depth+1)
+}
+
+func (e *archiveExpander) expandZip(path, name string, depth int) ([]string, error) {
+	zr, err := zip.OpenReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading zip %s: %w", name, err)
+	}
+	defer zr.Close()
+
+	files := []string{}
+	remaining := e.maxSize
+
+	for _, zf := range zr.File {
+		if zf.FileInfo().IsDir() || strings.HasPrefix(zf.Name, "__MACOSX/") {
+			continue
+		}
+
+		// only the base name is used, which guards against entries escaping
+		// the temporary directory
+		entryName := fmt.Sprintf("%s!%s", name, filepath.Base(zf.Name))
+
+		rc, err := zf.Open()
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", entryName, err)
+		}
+
+		out, err := e.extract(rc, entryName, remaining)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		stat, err := os.Stat(out)
+		if err != nil {
+			return nil, err
+		}
+		remaining -= stat.Size()
+
+		paths, err := e.expandFile(out, entryName, depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range paths {
+			if _, _, err := detectSbom(p); err != nil {
+				// archives often carry other files, like a README
+				continue
+			}
+			files = append(files, p)
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no sbom found in zip %s", name)
+	}
+
+	return files, nil
+}
+
+// extract writes at most limit bytes of r to a new file in the temporary
+// directory and returns its path.
+func (e *archiveExpander) extract(r io.Reader, name string, limit int64) (string, error) {
+	if e.dir == "" {
+		dir, err := os.MkdirTemp("", "sbomasm-")
+		if err != nil {
+			return "", err
+		}
+		e.dir = dir
+	}
+
+	e.count++
+	path := filepath.Join(e.dir, fmt.Sprintf("%d-%s", e.count, filepath.Base(name)))
+
+	f, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	n, err := io.Copy(f, io.LimitReader(r, limit+1))
+	if err != nil {
+		return "", fmt.Errorf("decompressing %s: %w", name, err)
+	}
+
+	if n > limit {
+		return "", fmt.Errorf("decompressed size of %s exceeds the limit of %d bytes", name, e.maxSize)
+	}
+
+	return path, nil
+}
+
+// cleanup removes the extracted files.
+func (e *archiveExpander) cleanup() error {
+	if e.dir == "" {
+		return nil
+	}
+	err := os.RemoveAll(e.dir)
+	e.dir = ""
+	return err
+}
@@ -0,0 +1,190 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assemble
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	cydx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/interlynk-io/sbomasm/pkg/logger"
+	"github.com/samber/lo"
+)
+
+// TestAssembleNestedAssembly assembles sboms which are themselves assemblies,
+// two and three levels deep, and expects each input to stay a separate nested
+// assembly at every level, each component once, with the dependencies of all
+// inputs remapped to the same refs.
+func TestAssembleNestedAssembly(t *testing.T) {
+	ctx := logger.WithLogger(context.Background())
+	dir := t.TempDir()
+
+	// assemble writes the assembly of files to dir and returns its path
+	assemble := func(name string, files ...string) string {
+		p := NewParams()
+		p.Ctx = &ctx
+		p.Name = name
+		p.Version = "1.0.0"
+		p.Type = "application"
+		p.Json = true
+		p.AssemblyMerge = true
+		p.Input = files
+
+		config, err := PopulateConfig(p)
+		if err != nil {
+			t.Fatalf("populate config: %v", err)
+		}
+		var out bytes.Buffer
+		if err := AssembleToWriter(config, &out); err != nil {
+			t.Fatalf("assemble %s: %v", name, err)
+		}
+
+		path := filepath.Join(dir, name+".cdx.json")
+		if err := os.WriteFile(path, out.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	fixture := func(name string) string {
+		return filepath.Join("testdata", name+".cdx.json")
+	}
+	inner := assemble("inner", fixture("app-a"), fixture("app-b"))
+	middle := assemble("middle", inner, fixture("app-c"))
+	outer := assemble("outer", middle, fixture("app-d"))
+
+	apps := map[string][]string{
+		"inner": {"app-a", "app-b"},
+		"app-a": {"github.com/spf13/cobra", "github.com/spf13/pflag", "go.uber.org/zap"},
+		"app-b": {"github.com/stretchr/testify", "gopkg.in/yaml.v3"},
+		"app-c": {"github.com/Masterminds/semver/v3"},
+	}
+	appDeps := map[string][]string{
+		"app-a":                  {"github.com/spf13/cobra", "go.uber.org/zap"},
+		"app-b":                  {"github.com/spf13/cobra", "github.com/stretchr/testify", "gopkg.in/yaml.v3"},
+		"app-c":                  {"github.com/Masterminds/semver/v3", "github.com/spf13/cobra"},
+		"github.com/spf13/cobra": {"github.com/spf13/pflag"},
+	}
+
+	tests := []struct {
+		name         string
+		path         string
+		wantChildren map[string][]string
+		wantDeps     map[string][]string
+	}{
+		{
+			name: "two levels",
+			path: middle,
+			wantChildren: lo.Assign(apps, map[string][]string{
+				"middle": {"app-c", "inner"},
+			}),
+			wantDeps: appDeps,
+		},
+		{
+			name: "three levels",
+			path: outer,
+			wantChildren: lo.Assign(apps, map[string][]string{
+				"outer":  {"app-d", "middle"},
+				"middle": {"app-c", "inner"},
+				"app-d":  {"golang.org/x/sync"},
+			}),
+			wantDeps: lo.Assign(appDeps, map[string][]string{
+				"app-d": {"go.uber.org/zap", "golang.org/x/sync"},
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.Open(tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer f.Close()
+
+			bom := &cydx.BOM{}
+			if err := cydx.NewBOMDecoder(f, cydx.BOMFileFormatJSON).Decode(bom); err != nil {
+				t.Fatalf("decode %s: %v", tt.path, err)
+			}
+
+			if n := len(lo.FromPtr(bom.Components)); n != 0 {
+				t.Errorf("assembled sbom has %d root components, want all of them nested", n)
+			}
+
+			// names of the nested components by their parent, and the names
+			// of all components by their ref
+			children := map[string][]string{}
+			names := map[string]string{}
+			var walk func(c cydx.Component)
+			walk = func(c cydx.Component) {
+				if lo.Contains(lo.Values(names), c.Name) {
+					t.Errorf("%s is nested more than once", c.Name)
+				}
+				if name, ok := names[c.BOMRef]; ok {
+					t.Errorf("bom-ref %s of %s is also the ref of %s", c.BOMRef, c.Name, name)
+				}
+				names[c.BOMRef] = c.Name
+				for _, child := range lo.FromPtr(c.Components) {
+					children[c.Name] = append(children[c.Name], child.Name)
+					walk(child)
+				}
+			}
+			walk(*bom.Metadata.Component)
+
+			for name, want := range tt.wantChildren {
+				got := children[name]
+				sort.Strings(got)
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("components nested in %s = %v, want %v", name, got, want)
+				}
+			}
+			for name, got := range children {
+				if _, ok := tt.wantChildren[name]; !ok {
+					t.Errorf("%s has nested components %v, want none", name, got)
+				}
+			}
+
+			deps := map[string][]string{}
+			for _, d := range lo.FromPtr(bom.Dependencies) {
+				ref, ok := names[d.Ref]
+				if !ok {
+					t.Errorf("dependency ref %s is not a component of the assembled sbom", d.Ref)
+				}
+				for _, on := range lo.FromPtr(d.Dependencies) {
+					name, ok := names[on]
+					if !ok {
+						t.Errorf("%s depends on %s, which is not a component of the assembled sbom", ref, on)
+					}
+					deps[ref] = append(deps[ref], name)
+				}
+			}
+
+			for name, want := range tt.wantDeps {
+				got := deps[name]
+				sort.Strings(got)
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("dependencies of %s = %v, want %v", name, got, want)
+				}
+			}
+		})
+	}
+}
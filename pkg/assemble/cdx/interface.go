@@ -18,11 +18,16 @@ package cdx
 import (
 	"context"
 	"errors"
+	"io"
+	"sort"
 	"strings"
 
 	cydx "github.com/CycloneDX/cyclonedx-go"
 	"github.com/google/uuid"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/filter"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/report"
 	"github.com/samber/lo"
+	"go.uber.org/zap"
 )
 
 var cdx_strings_to_types = map[string]cydx.ComponentType{
@@ -52,7 +57,9 @@ var cdx_hash_algos = map[string]cydx.HashAlgorithm{
 }
 
 func SupportedChecksums() []string {
-	return lo.Keys(cdx_hash_algos)
+	algos := lo.Keys(cdx_hash_algos)
+	sort.Strings(algos)
+	return algos
 }
 
 func IsSupportedChecksum(algo, value string) bool {
@@ -63,6 +70,28 @@ func IsSupportedChecksum(algo, value string) bool {
 	return false
 }
 
+// ValidSpecVersion reports whether sboms can be assembled in the CycloneDX
+// spec version.
+func ValidSpecVersion(specVersion string) bool {
+	return validSpecVersion(specVersion)
+}
+
+// Encode writes an assembled bom in the file format, json, xml or proto, and
+// spec version.
+func Encode(w io.Writer, bom *cydx.BOM, fileFormat, specVersion string, log *zap.SugaredLogger) error {
+	if fileFormat == "proto" {
+		return writeProto(w, bom, specVersion, log)
+	}
+	return encode(w, bom, fileFormat, specVersion, log)
+}
+
+// SupportsProto reports whether CycloneDX defines a protobuf schema for the
+// spec version.
+func SupportsProto(specVersion string) bool {
+	_, ok := protoBoms[specVersion]
+	return ok
+}
+
 type Author struct {
 	Name  string
 	Email string
@@ -102,7 +131,10 @@ type output struct {
 	FileFormat      string
 	Spec            string
 	SpecVersion     string
+	Timestamp       string
+	NoTimestamp     bool
 	File            string
+	Writer          io.Writer
 	Upload          bool
 	UploadProjectID uuid.UUID
 	Url             string
@@ -111,6 +143,9 @@ type output struct {
 
 type input struct {
 	Files []string
+	// Names are the files as given by the user, files extracted from an
+	// archive are named after their location in it
+	Names []string
 }
 
 type assemble struct {
@@ -120,6 +155,19 @@ type assemble struct {
 	FlatMerge                  bool
 	HierarchicalMerge          bool
 	AssemblyMerge              bool
+	DedupBy                    string
+	LowMemory                  bool
+	StrictLicenses             bool
+	Filter                     filter.Filter
+	MinComponents              int
+}
+
+// build is the version of sbomasm, with the commit and build date when they
+// were embedded at build time
+type build struct {
+	Version   string
+	GitCommit string
+	BuildDate string
 }
 
 type MergeSettings struct {
@@ -128,6 +176,10 @@ type MergeSettings struct {
 	Output   output
 	Input    input
 	Assemble assemble
+	Build    build
+
+	// Report is filled with the statistics of the merge when set
+	Report *report.Report
 }
 
 func Merge(ms *MergeSettings) error {
@@ -139,6 +191,10 @@ func Merge(ms *MergeSettings) error {
 		return errors.New("invalid CycloneDX spec version")
 	}
 
+	if ms.Assemble.LowMemory {
+		return newStreamMerge(ms).streamedMerge()
+	}
+
 	merger := newMerge(ms)
 	return merger.combinedMerge()
 }
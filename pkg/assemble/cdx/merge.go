@@ -18,14 +18,19 @@ package cdx
 
 import (
 	"encoding/base64"
+	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 
 	cydx "github.com/CycloneDX/cyclonedx-go"
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/filter"
 	"github.com/interlynk-io/sbomasm/pkg/logger"
+	"github.com/interlynk-io/sbomasm/pkg/progress"
 	"github.com/samber/lo"
+	"go.uber.org/zap"
 )
 
 type merge struct {
@@ -42,40 +47,91 @@ func newMerge(ms *MergeSettings) *merge {
 	}
 }
 
-func (m *merge) loadBoms() {
+// loadBoms parses the input sboms, it stops when the context is canceled.
+func (m *merge) loadBoms() error {
+	ctx := *m.settings.Ctx
+	p := progress.FromContext(ctx)
+
+	p.Start("parsing sboms", len(m.settings.Input.Files))
+	defer p.Done()
+
 	for _, path := range m.settings.Input.Files {
-		bom, err := loadBom(*m.settings.Ctx, path)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		bom, err := loadBom(ctx, path, m.settings.Report)
 		if err != nil {
-			panic(err) // TODO: return error instead of panic
+			return fmt.Errorf("unable to load %s: %w", path, err)
 		}
 		m.in = append(m.in, bom)
+		p.Step(filepath.Base(path))
 	}
+	return nil
 }
 
 func (m *merge) combinedMerge() error {
 	log := logger.FromContext(*m.settings.Ctx)
 
 	log.Debug("loading sboms")
-	m.loadBoms()
+	if err := m.loadBoms(); err != nil {
+		return err
+	}
+
+	// Each sbom is nested under its primary component
+	if !m.settings.Assemble.FlatMerge {
+		for i, b := range m.in {
+			ensurePrimaryComp(b, i, m.settings.Input.Names[i], log)
+		}
+	}
 
 	log.Debugf("initialize component service")
 	//cs := newComponentService(*m.settings.Ctx)
-	cs := newUniqueComponentService(*m.settings.Ctx)
+	cs := newUniqueComponentService(*m.settings.Ctx, m.settings.Assemble.DedupBy)
+	cs.report = m.settings.Report
 
 	// Build primary component list from each sbom
-	priCompList := buildPrimaryComponentList(m.in, cs)
-	log.Debugf("build primary component list for each sbom found %d", len(priCompList))
+	priCompRefs := buildPrimaryComponentList(m.in, m.settings.Input.Files, cs)
+	log.Debugf("build primary component list for each sbom found %d", len(priCompRefs))
 
 	// Build a flat list of components from each sbom
-	compList := buildComponentList(m.in, cs)
+	compRefs := buildComponentList(m.in, m.settings.Input.Files, cs, m.settings.Assemble.FlatMerge)
+	log.Debugf("deduplicated %d components by %s", cs.duplicates, m.settings.Assemble.DedupBy)
+
+	if err := checkLicenses(m.settings, cs); err != nil {
+		return err
+	}
+
+	if err := (*m.settings.Ctx).Err(); err != nil {
+		return err
+	}
+
+	// duplicates have been merged into the kept components, copy them now
+	priCompList := derefComponents(priCompRefs, cs)
+	compList := derefComponents(compRefs, cs)
 	log.Debugf("build a flat list of components from each sbom found %d", len(compList))
 
 	// Build a flat list of dependencies from each sbom
-	depList := buildDependencyList(m.in, cs)
+	depList, unresolvedDeps := buildDependencyList(m.in, m.settings.Input.Files, cs)
 	log.Debugf("build a flat list of dependencies from each sbom found %d", len(depList))
 
+	// Components are filtered once deduplicated, the primary components of
+	// the sboms are always kept
+	dropped := map[string]bool{}
+	if f := m.settings.Assemble.Filter; !f.Empty() {
+		compList = filterComponents(compList, f, dropped)
+		for i := range priCompList {
+			if priCompList[i].Components != nil {
+				nested := filterComponents(*priCompList[i].Components, f, dropped)
+				priCompList[i].Components = &nested
+			}
+		}
+		depList = pruneDependencies(depList, dropped)
+		log.Debugf("filtered %d components by scope and type", len(dropped))
+	}
+
 	// build a list of tools from each sbom
-	toolsList := buildToolList(m.in)
+	toolsList := buildToolList(m.in, m.settings.Build)
 	log.Debugf("build a list of tools from each sbom found comps: %d, service: %d", len(*toolsList.Components), len(*toolsList.Services))
 
 	//Build the final sbom
@@ -88,6 +144,10 @@ func (m *merge) combinedMerge() error {
 	log.Debugf("assign tools to metadata")
 	m.out.Metadata.Tools = toolsList
 
+	m.out.Metadata.Properties = &[]cydx.Property{
+		{Name: "sbomasm:dedup_by", Value: m.settings.Assemble.DedupBy},
+	}
+
 	if m.settings.Assemble.FlatMerge {
 		finalCompList := []cydx.Component{}
 		finalCompList = append(finalCompList, priCompList...)
@@ -105,17 +165,36 @@ func (m *merge) combinedMerge() error {
 		log.Debugf("flat merge: final dependency list: %d", len(depList))
 		m.out.Dependencies = &depList
 	} else if m.settings.Assemble.AssemblyMerge {
-		// Add the sbom primary components to the new primary component
-		m.out.Metadata.Component.Components = &priCompList
-		m.out.Components = &compList
+		// Each sbom becomes an assembly nested under the new primary
+		// component, holding the components of the sbom
+		assemblies, rest := nestAssemblies(m.in, m.settings.Input.Files, cs, priCompList, compList)
+		m.out.Metadata.Component.Components = &assemblies
+		m.out.Components = &rest
 		m.out.Dependencies = &depList
 
-		log.Debugf("assembly merge: final component list: %d", len(compList))
+		log.Debugf("assembly merge: final component list: %d", countComponents(&assemblies)+len(rest))
 		log.Debugf("assembly merge: final dependency list: %d", len(depList))
 	} else {
-		for _, b := range m.in {
+		//Initialize the components list for the primary components, which
+		//may be shared by several sboms
+		for i := range priCompList {
+			if priCompList[i].Components == nil {
+				priCompList[i].Components = &[]cydx.Component{}
+			}
+		}
+
+		// Deduplicated components resolve to the same ref, each is nested
+		// once under its primary component
+		nested := make([]map[string]bool, len(priCompList))
+		for i := range nested {
+			nested[i] = map[string]bool{}
+		}
+
+		for i, b := range m.in {
 			var oldPc *cydx.Component
-			var newPc int
+			newPc := -1
+
+			cs.setInput(m.settings.Input.Files[i])
 
 			if b.Metadata != nil && b.Metadata.Component != nil {
 				oldPc = b.Metadata.Component
@@ -130,11 +209,18 @@ func (m *merge) combinedMerge() error {
 				}
 			}
 
-			//Initialize the components list for the primary component
-			priCompList[newPc].Components = &[]cydx.Component{}
+			// The primary component is nested in the tree of another one
+			if newPc == -1 {
+				continue
+			}
 
 			for _, oldComp := range lo.FromPtr(b.Components) {
 				newCompId, _ := cs.ResolveDepID(oldComp.BOMRef)
+				if nested[newPc][newCompId] {
+					continue
+				}
+				nested[newPc][newCompId] = true
+
 				for _, comp := range compList {
 					if comp.BOMRef == newCompId {
 						*priCompList[newPc].Components = append(*priCompList[newPc].Components, comp)
@@ -159,17 +245,52 @@ func (m *merge) combinedMerge() error {
 		log.Debugf("hierarchical merge: final dependency list: %d", len(depList))
 	}
 
+	if m.settings.Report != nil {
+		s := &m.settings.Report.Summary
+		s.Spec = "cyclonedx"
+		s.SpecVersion = m.settings.Output.SpecVersion
+		s.MergeMode = mergeMode(m.settings)
+		s.Inputs = len(m.in)
+		s.Components = countComponents(m.out.Components) + countComponents(m.out.Metadata.Component.Components)
+		s.DuplicateComponents = cs.duplicates
+		s.FilteredComponents = len(dropped)
+		s.Dependencies = countDependencies(m.out.Dependencies)
+		s.UnresolvedDependencies = unresolvedDeps
+	}
+
+	count := countComponents(m.out.Components) + countComponents(m.out.Metadata.Component.Components)
+	if err := filter.CheckMinComponents(count, m.settings.Assemble.MinComponents); err != nil {
+		return err
+	}
+
+	sortBom(m.out)
+
 	// Writes sbom to file or uploads
 	log.Debugf("writing sbom")
 	return m.processSBOM()
 }
 
+func mergeMode(ms *MergeSettings) string {
+	switch {
+	case ms.Assemble.FlatMerge:
+		return "flat"
+	case ms.Assemble.AssemblyMerge:
+		return "assembly"
+	}
+	return "hierarchical"
+}
+
 func (m *merge) initOutBom() {
 	//log := logger.FromContext(*m.settings.Ctx)
-	m.out.SerialNumber = newSerialNumber()
+	m.out.SerialNumber = newSerialNumber(serialSeed(m.settings))
 
 	m.out.Metadata = &cydx.Metadata{}
-	m.out.Metadata.Timestamp = utcNowTime()
+	if !m.settings.Output.NoTimestamp {
+		m.out.Metadata.Timestamp = m.settings.Output.Timestamp
+		if m.out.Metadata.Timestamp == "" {
+			m.out.Metadata.Timestamp = utcNowTime()
+		}
+	}
 
 	if m.settings.App.Supplier.Name != "" || m.settings.App.Supplier.Email != "" {
 		m.out.Metadata.Supplier = &cydx.OrganizationalEntity{}
@@ -246,7 +367,7 @@ func (m *merge) setupPrimaryComp() *cydx.Component {
 		}
 	}
 
-	pc.BOMRef = newBomRef()
+	pc.BOMRef = primaryBomRef(pc.Name, pc.Version)
 	return &pc
 }
 
@@ -258,48 +379,49 @@ func (m *merge) processSBOM() error {
 
 	if m.settings.Output.Upload {
 		output = &sb
-	} else if m.settings.Output.File == "" {
-		output = os.Stdout
+	} else if m.settings.Output.Writer != nil {
+		output = m.settings.Output.Writer
 	} else {
-		f, err := os.Create(m.settings.Output.File)
-		if err != nil {
-			return err
-		}
-		defer f.Close()
-		output = f
+		output = os.Stdout
+	}
+
+	if m.settings.Output.FileFormat == "proto" {
+		return writeProto(output, m.out, m.settings.Output.SpecVersion, log)
 	}
 
+	if err := encode(output, m.out, m.settings.Output.FileFormat, m.settings.Output.SpecVersion, log); err != nil {
+		return err
+	}
+
+	if m.settings.Output.Upload {
+		return m.uploadToServer(sb.String())
+	}
+
+	return nil
+}
+
+// encode writes bom as json or xml in the spec version, or the version of the
+// bom when none is set.
+func encode(w io.Writer, bom *cydx.BOM, fileFormat, specVersion string, log *zap.SugaredLogger) error {
 	var encoder cydx.BOMEncoder
-	switch m.settings.Output.FileFormat {
+	switch fileFormat {
 	case "xml":
 		log.Debugf("writing sbom in xml format")
-		encoder = cydx.NewBOMEncoder(output, cydx.BOMFileFormatXML)
+		encoder = cydx.NewBOMEncoder(w, cydx.BOMFileFormatXML)
 	default:
 		log.Debugf("writing sbom in json format")
-		encoder = cydx.NewBOMEncoder(output, cydx.BOMFileFormatJSON)
+		encoder = cydx.NewBOMEncoder(w, cydx.BOMFileFormatJSON)
 	}
 
 	encoder.SetPretty(true)
 	encoder.SetEscapeHTML(true)
 
-	var err error
-	if m.settings.Output.SpecVersion == "" {
-		err = encoder.Encode(m.out)
-	} else {
-		log.Debugf("writing sbom in version %s", m.settings.Output.SpecVersion)
-		outputVersion := specVersionMap[m.settings.Output.SpecVersion]
-		err = encoder.EncodeVersion(m.out, outputVersion)
-	}
-
-	if err != nil {
-		return err
+	if specVersion == "" {
+		return encoder.Encode(bom)
 	}
 
-	if m.settings.Output.Upload {
-		return m.uploadToServer(sb.String())
-	}
-
-	return nil
+	log.Debugf("writing sbom in version %s", specVersion)
+	return encoder.EncodeVersion(bom, specVersionMap[specVersion])
 }
 
 func (m *merge) uploadToServer(bomContent string) error {
@@ -310,8 +432,7 @@ func (m *merge) uploadToServer(bomContent string) error {
 	dTrackClient, err := dtrack.NewClient(m.settings.Output.Url,
 		dtrack.WithAPIKey(m.settings.Output.ApiKey), dtrack.WithDebug(false))
 	if err != nil {
-		log.Fatalf("Failed to create Dependency-Track client: %s", err)
-		return err
+		return fmt.Errorf("failed to create Dependency-Track client: %w", err)
 	}
 
 	encodedBOM := base64.StdEncoding.EncodeToString([]byte(bomContent))
@@ -322,8 +443,7 @@ func (m *merge) uploadToServer(bomContent string) error {
 
 	token, err := dTrackClient.BOM.Upload(*m.settings.Ctx, bomUploadRequest)
 	if err != nil {
-		log.Fatalf("Failed to upload BOM: %s", err)
-		return err
+		return fmt.Errorf("failed to upload sbom to project %s: %w", m.settings.Output.UploadProjectID, err)
 	}
 
 	log.Debugf("bom upload token: %v", token)
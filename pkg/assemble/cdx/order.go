@@ -0,0 +1,141 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdx
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	cydx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// The assembled sbom only depends on its inputs and settings: ids are derived
+// instead of random and lists are sorted, so identical assemblies with a fixed
+// timestamp are byte identical.
+
+// stableUUID derives a uuid from seed.
+func stableUUID(seed string) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte("sbomasm:"+seed)).String()
+}
+
+// serialSeed identifies an assembly by its settings and the content of its
+// inputs.
+func serialSeed(ms *MergeSettings) string {
+	h := sha256.New()
+
+	settings, _ := json.Marshal(struct {
+		App         app
+		Assemble    assemble
+		SpecVersion string
+		Timestamp   string
+	}{ms.App, ms.Assemble, ms.Output.SpecVersion, ms.Output.Timestamp})
+	h.Write(settings)
+
+	for _, path := range ms.Input.Files {
+		f, err := os.Open(path)
+		if err != nil {
+			fmt.Fprintf(h, "%s\n", path)
+			continue
+		}
+		io.Copy(h, f)
+		f.Close()
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// sortBom sorts the components, dependencies and licenses of the bom.
+func sortBom(bom *cydx.BOM) {
+	if bom.Metadata != nil && bom.Metadata.Component != nil {
+		sortLicenses(bom.Metadata.Component.Licenses)
+		sortComponents(bom.Metadata.Component.Components)
+	}
+	sortComponents(bom.Components)
+	sortDependencies(bom.Dependencies)
+}
+
+// sortComponents sorts components by purl, then name and version, nested
+// components included. The bom-ref breaks ties.
+func sortComponents(comps *[]cydx.Component) {
+	if comps == nil {
+		return
+	}
+
+	for i := range *comps {
+		sortLicenses((*comps)[i].Licenses)
+		sortComponents((*comps)[i].Components)
+	}
+
+	sort.SliceStable(*comps, func(i, j int) bool {
+		return compareComponents(&(*comps)[i], &(*comps)[j]) < 0
+	})
+}
+
+func compareComponents(a, b *cydx.Component) int {
+	for _, f := range [][2]string{
+		{a.PackageURL, b.PackageURL},
+		{a.Group, b.Group},
+		{a.Name, b.Name},
+		{a.Version, b.Version},
+		{a.BOMRef, b.BOMRef},
+	} {
+		if c := strings.Compare(f[0], f[1]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// sortDependencies sorts dependencies by ref and the refs each depends on.
+func sortDependencies(deps *[]cydx.Dependency) {
+	if deps == nil {
+		return
+	}
+
+	for _, d := range *deps {
+		if d.Dependencies != nil {
+			sort.Strings(*d.Dependencies)
+		}
+	}
+
+	sort.SliceStable(*deps, func(i, j int) bool {
+		return (*deps)[i].Ref < (*deps)[j].Ref
+	})
+}
+
+// sortLicenses sorts a list of licenses by id, name or expression. A license
+// expression is the only entry of its list, so it is left as is.
+func sortLicenses(licenses *cydx.Licenses) {
+	if licenses == nil || len(*licenses) < 2 {
+		return
+	}
+
+	key := func(l cydx.LicenseChoice) string {
+		return lo.FirstOr(licenseStrings(&cydx.Licenses{l}), "")
+	}
+
+	sort.SliceStable(*licenses, func(i, j int) bool {
+		return key((*licenses)[i]) < key((*licenses)[j])
+	})
+}
@@ -0,0 +1,782 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdx
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	cydx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/cdx/proto/v1_4"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/cdx/proto/v1_5"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/cdx/proto/v1_6"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// The packages in proto are generated from bom-1.x.proto of the CycloneDX
+// specification at commit 4c845153c5aa, with protoc-gen-go v1.36.5.
+//go:generate protoc --proto_path=proto/v1_4 --go_out=proto/v1_4 --go_opt=paths=source_relative --go_opt=Mbom-1.4.proto=github.com/interlynk-io/sbomasm/pkg/assemble/cdx/proto/v1_4 bom-1.4.proto
+//go:generate protoc --proto_path=proto/v1_5 --go_out=proto/v1_5 --go_opt=paths=source_relative --go_opt=Mbom-1.5.proto=github.com/interlynk-io/sbomasm/pkg/assemble/cdx/proto/v1_5 bom-1.5.proto
+//go:generate protoc --proto_path=proto/v1_6 --go_out=proto/v1_6 --go_opt=paths=source_relative --go_opt=Mbom-1.6.proto=github.com/interlynk-io/sbomasm/pkg/assemble/cdx/proto/v1_6 bom-1.6.proto
+
+// protoBoms are the Bom messages of the spec versions with a CycloneDX
+// protobuf schema
+var protoBoms = map[string]func() proto.Message{
+	"1.4": func() proto.Message { return &v1_4.Bom{} },
+	"1.5": func() proto.Message { return &v1_5.Bom{} },
+	"1.6": func() proto.Message { return &v1_6.Bom{} },
+}
+
+// timestampLayouts are tried in order for the timestamps of the bom, the ones
+// after RFC 3339 are read as UTC
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+}
+
+// writeProto writes the bom as CycloneDX protobuf of the spec version. The bom
+// is first encoded as json of that version, so fields not defined by it are
+// dropped the same way as for json and xml output. It is then mapped onto the
+// 1.6 messages and copied into the ones of the spec version.
+func writeProto(w io.Writer, bom *cydx.BOM, specVersion string, log *zap.SugaredLogger) error {
+	if specVersion == "" {
+		specVersion = "1.6"
+	}
+	newBom, ok := protoBoms[specVersion]
+	if !ok {
+		return fmt.Errorf("protobuf is not defined for CycloneDX %s", specVersion)
+	}
+
+	version, ok := specVersionMap[specVersion]
+	if !ok {
+		return fmt.Errorf("invalid CycloneDX spec version %s", specVersion)
+	}
+
+	var buf bytes.Buffer
+	err := cydx.NewBOMEncoder(&buf, cydx.BOMFileFormatJSON).EncodeVersion(bom, version)
+	if err != nil {
+		return err
+	}
+
+	var versioned cydx.BOM
+	err = cydx.NewBOMDecoder(&buf, cydx.BOMFileFormatJSON).Decode(&versioned)
+	if err != nil {
+		return err
+	}
+
+	e := &protoEncoder{dropped: map[string]int{}}
+	var msg proto.Message = e.bom(&versioned, specVersion)
+	if specVersion != "1.6" {
+		msg = e.downgrade(msg.(*v1_6.Bom), newBom())
+	}
+
+	if dropped := e.droppedParts(); len(dropped) > 0 {
+		log.Warnf("protobuf output leaves out %s", strings.Join(dropped, ", "))
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	log.Debugf("writing sbom in protobuf format, %d bytes", len(data))
+
+	_, err = w.Write(data)
+	return err
+}
+
+// protoEncoder maps a bom onto the messages of bom-1.6.proto, dropped counts
+// the parts of the bom which have no place in them
+type protoEncoder struct {
+	dropped map[string]int
+}
+
+func (e *protoEncoder) drop(part string, present bool) {
+	if present {
+		e.dropped[part]++
+	}
+}
+
+func (e *protoEncoder) droppedParts() []string {
+	dropped := []string{}
+	for part, n := range e.dropped {
+		dropped = append(dropped, fmt.Sprintf("%s (%d)", part, n))
+	}
+	sort.Strings(dropped)
+	return dropped
+}
+
+func (e *protoEncoder) bom(bom *cydx.BOM, specVersion string) *v1_6.Bom {
+	e.drop("vulnerabilities", bom.Vulnerabilities != nil && len(*bom.Vulnerabilities) > 0)
+	e.drop("annotations", bom.Annotations != nil && len(*bom.Annotations) > 0)
+	e.drop("formulation", bom.Formulation != nil && len(*bom.Formulation) > 0)
+	e.drop("declarations", bom.Declarations != nil)
+	e.drop("definitions", bom.Definitions != nil)
+
+	m := &v1_6.Bom{
+		SpecVersion:        specVersion,
+		SerialNumber:       protoString(bom.SerialNumber),
+		Components:         e.components(bom.Components),
+		Services:           e.services(bom.Services),
+		ExternalReferences: e.externalReferences(bom.ExternalReferences),
+		Properties:         protoProperties(bom.Properties),
+	}
+	if bom.Version != 0 {
+		m.Version = proto.Int32(int32(bom.Version))
+	}
+	if bom.Metadata != nil {
+		m.Metadata = e.metadata(bom.Metadata)
+	}
+	if bom.Dependencies != nil {
+		for _, d := range *bom.Dependencies {
+			dm := &v1_6.Dependency{Ref: d.Ref}
+			if d.Dependencies != nil {
+				for _, ref := range *d.Dependencies {
+					dm.Dependencies = append(dm.Dependencies, &v1_6.Dependency{Ref: ref})
+				}
+			}
+			m.Dependencies = append(m.Dependencies, dm)
+		}
+	}
+	if bom.Compositions != nil {
+		for _, c := range *bom.Compositions {
+			aggregate, _ := protoEnum[v1_6.Aggregate](e, v1_6.Aggregate_value, "AGGREGATE_", string(c.Aggregate))
+			m.Compositions = append(m.Compositions, &v1_6.Composition{
+				Aggregate:       aggregate,
+				Assemblies:      protoRefs(c.Assemblies),
+				Dependencies:    protoRefs(c.Dependencies),
+				Vulnerabilities: protoRefs(c.Vulnerabilities),
+				BomRef:          protoString(c.BOMRef),
+			})
+		}
+	}
+	return m
+}
+
+func (e *protoEncoder) metadata(md *cydx.Metadata) *v1_6.Metadata {
+	m := &v1_6.Metadata{
+		Timestamp:    e.timestamp(md.Timestamp),
+		Authors:      protoContacts(md.Authors),
+		Manufacture:  protoEntity(md.Manufacture),
+		Manufacturer: protoEntity(md.Manufacturer),
+		Supplier:     protoEntity(md.Supplier),
+		Licenses:     e.licenses(md.Licenses),
+		Properties:   protoProperties(md.Properties),
+	}
+	if md.Component != nil {
+		m.Component = e.component(md.Component)
+	}
+	if md.Tools != nil {
+		m.Tools = e.tools(md.Tools)
+	}
+	if md.Lifecycles != nil {
+		for _, l := range *md.Lifecycles {
+			lm := &v1_6.Lifecycles{Description: protoString(l.Description)}
+			if phase, ok := protoEnum[v1_6.LifecyclePhase](e, v1_6.LifecyclePhase_value, "LIFECYCLE_PHASE_", string(l.Phase)); ok {
+				lm.Choice = &v1_6.Lifecycles_Phase{Phase: phase}
+			} else {
+				lm.Choice = &v1_6.Lifecycles_Name{Name: l.Name}
+			}
+			m.Lifecycles = append(m.Lifecycles, lm)
+		}
+	}
+	return m
+}
+
+// tools maps the tools of the metadata, tools of the legacy list are written
+// as components. Before 1.5 each of them becomes a legacy tool again when the
+// bom is copied into the spec version.
+func (e *protoEncoder) tools(tools *cydx.ToolsChoice) *v1_6.Tool {
+	m := &v1_6.Tool{
+		Components: e.components(tools.Components),
+		Services:   e.services(tools.Services),
+	}
+	if tools.Tools != nil {
+		for _, t := range *tools.Tools {
+			m.Components = append(m.Components, &v1_6.Component{
+				Type:               v1_6.Classification_CLASSIFICATION_APPLICATION,
+				Publisher:          protoString(t.Vendor),
+				Name:               t.Name,
+				Version:            t.Version,
+				Hashes:             e.hashes(t.Hashes),
+				ExternalReferences: e.externalReferences(t.ExternalReferences),
+			})
+		}
+	}
+	return m
+}
+
+func (e *protoEncoder) components(comps *[]cydx.Component) []*v1_6.Component {
+	if comps == nil {
+		return nil
+	}
+	ms := make([]*v1_6.Component, 0, len(*comps))
+	for i := range *comps {
+		ms = append(ms, e.component(&(*comps)[i]))
+	}
+	return ms
+}
+
+func (e *protoEncoder) component(c *cydx.Component) *v1_6.Component {
+	e.drop("component model card", c.ModelCard != nil)
+	e.drop("component data", c.Data != nil)
+	e.drop("component crypto properties", c.CryptoProperties != nil)
+
+	typ, _ := protoEnum[v1_6.Classification](e, v1_6.Classification_value, "CLASSIFICATION_", string(c.Type))
+	m := &v1_6.Component{
+		Type:               typ,
+		MimeType:           protoString(c.MIMEType),
+		BomRef:             protoString(c.BOMRef),
+		Supplier:           protoEntity(c.Supplier),
+		Manufacturer:       protoEntity(c.Manufacturer),
+		Author:             protoString(c.Author),
+		Authors:            protoContacts(c.Authors),
+		Publisher:          protoString(c.Publisher),
+		Group:              protoString(c.Group),
+		Name:               c.Name,
+		Version:            c.Version,
+		Description:        protoString(c.Description),
+		Hashes:             e.hashes(c.Hashes),
+		Licenses:           e.licenses(c.Licenses),
+		Copyright:          protoString(c.Copyright),
+		Cpe:                protoString(c.CPE),
+		Purl:               protoString(c.PackageURL),
+		OmniborId:          protoStrings(c.OmniborID),
+		Swhid:              protoStrings(c.SWHID),
+		Swid:               protoSwid(c.SWID),
+		Modified:           c.Modified,
+		ExternalReferences: e.externalReferences(c.ExternalReferences),
+		Components:         e.components(c.Components),
+		Properties:         protoProperties(c.Properties),
+		ReleaseNotes:       e.releaseNotes(c.ReleaseNotes),
+	}
+	if scope, ok := protoEnum[v1_6.Scope](e, v1_6.Scope_value, "SCOPE_", string(c.Scope)); ok {
+		m.Scope = &scope
+	}
+	if p := c.Pedigree; p != nil {
+		m.Pedigree = &v1_6.Pedigree{
+			Ancestors:   e.components(p.Ancestors),
+			Descendants: e.components(p.Descendants),
+			Variants:    e.components(p.Variants),
+			Commits:     e.commits(p.Commits),
+			Patches:     e.patches(p.Patches),
+			Notes:       protoString(p.Notes),
+		}
+	}
+	if c.Evidence != nil {
+		m.Evidence = e.evidence(c.Evidence)
+	}
+	return m
+}
+
+func (e *protoEncoder) evidence(ev *cydx.Evidence) *v1_6.Evidence {
+	e.drop("component evidence callstack", ev.Callstack != nil)
+
+	m := &v1_6.Evidence{Licenses: e.licenses(ev.Licenses)}
+	if ev.Copyright != nil {
+		for _, c := range *ev.Copyright {
+			m.Copyright = append(m.Copyright, &v1_6.EvidenceCopyright{Text: c.Text})
+		}
+	}
+	if id := ev.Identity; id != nil {
+		field, _ := protoEnum[v1_6.EvidenceFieldType](e, v1_6.EvidenceFieldType_value, "EVIDENCE_FIELD_", string(id.Field))
+		im := &v1_6.EvidenceIdentity{Field: field, Confidence: id.Confidence}
+		if id.Methods != nil {
+			for _, method := range *id.Methods {
+				technique, ok := protoEnum[v1_6.EvidenceTechnique](e, v1_6.EvidenceTechnique_value, "EVIDENCE_TECHNIQUE_", string(method.Technique))
+				if !ok {
+					continue
+				}
+				mm := &v1_6.EvidenceMethods{Technique: technique, Value: protoString(method.Value)}
+				if method.Confidence != nil {
+					mm.Confidence = *method.Confidence
+				}
+				im.Methods = append(im.Methods, mm)
+			}
+		}
+		if id.Tools != nil {
+			im.Tools = protoRefs(id.Tools)
+		}
+		m.Identity = append(m.Identity, im)
+	}
+	if ev.Occurrences != nil {
+		for _, o := range *ev.Occurrences {
+			om := &v1_6.EvidenceOccurrences{
+				BomRef:            protoString(o.BOMRef),
+				Location:          o.Location,
+				Symbol:            protoString(o.Symbol),
+				AdditionalContext: protoString(o.AdditionalContext),
+			}
+			if o.Line != nil {
+				om.Line = proto.Int32(int32(*o.Line))
+			}
+			if o.Offset != nil {
+				om.Offset = proto.Int32(int32(*o.Offset))
+			}
+			m.Occurrences = append(m.Occurrences, om)
+		}
+	}
+	return m
+}
+
+func (e *protoEncoder) commits(commits *[]cydx.Commit) []*v1_6.Commit {
+	if commits == nil {
+		return nil
+	}
+	ms := make([]*v1_6.Commit, 0, len(*commits))
+	for _, c := range *commits {
+		ms = append(ms, &v1_6.Commit{
+			Uid:       protoString(c.UID),
+			Url:       protoString(c.URL),
+			Author:    e.action(c.Author),
+			Committer: e.action(c.Committer),
+			Message:   protoString(c.Message),
+		})
+	}
+	return ms
+}
+
+func (e *protoEncoder) action(a *cydx.IdentifiableAction) *v1_6.IdentifiableAction {
+	if a == nil {
+		return nil
+	}
+	return &v1_6.IdentifiableAction{
+		Timestamp: e.timestamp(a.Timestamp),
+		Name:      protoString(a.Name),
+		Email:     protoString(a.Email),
+	}
+}
+
+func (e *protoEncoder) patches(patches *[]cydx.Patch) []*v1_6.Patch {
+	if patches == nil {
+		return nil
+	}
+	ms := make([]*v1_6.Patch, 0, len(*patches))
+	for _, p := range *patches {
+		typ, _ := protoEnum[v1_6.PatchClassification](e, v1_6.PatchClassification_value, "PATCH_CLASSIFICATION_", string(p.Type))
+		pm := &v1_6.Patch{Type: typ, Resolves: e.issues(p.Resolves)}
+		if p.Diff != nil {
+			pm.Diff = &v1_6.Diff{Text: protoText(p.Diff.Text), Url: protoString(p.Diff.URL)}
+		}
+		ms = append(ms, pm)
+	}
+	return ms
+}
+
+func (e *protoEncoder) issues(issues *[]cydx.Issue) []*v1_6.Issue {
+	if issues == nil {
+		return nil
+	}
+	ms := make([]*v1_6.Issue, 0, len(*issues))
+	for _, i := range *issues {
+		typ, _ := protoEnum[v1_6.IssueClassification](e, v1_6.IssueClassification_value, "ISSUE_CLASSIFICATION_", string(i.Type))
+		im := &v1_6.Issue{
+			Type:        typ,
+			Id:          protoString(i.ID),
+			Name:        protoString(i.Name),
+			Description: protoString(i.Description),
+			References:  protoStrings(i.References),
+		}
+		if i.Source != nil {
+			im.Source = &v1_6.Source{Name: protoString(i.Source.Name), Url: protoString(i.Source.URL)}
+		}
+		ms = append(ms, im)
+	}
+	return ms
+}
+
+func (e *protoEncoder) releaseNotes(rn *cydx.ReleaseNotes) *v1_6.ReleaseNotes {
+	if rn == nil {
+		return nil
+	}
+	m := &v1_6.ReleaseNotes{
+		Type:          rn.Type,
+		Title:         protoString(rn.Title),
+		FeaturedImage: protoString(rn.FeaturedImage),
+		SocialImage:   protoString(rn.SocialImage),
+		Description:   protoString(rn.Description),
+		Timestamp:     e.timestamp(rn.Timestamp),
+		Aliases:       protoStrings(rn.Aliases),
+		Tags:          protoStrings(rn.Tags),
+		Resolves:      e.issues(rn.Resolves),
+		Properties:    protoProperties(rn.Properties),
+	}
+	if rn.Notes != nil {
+		for _, n := range *rn.Notes {
+			m.Notes = append(m.Notes, &v1_6.Note{Locale: protoString(n.Locale), Text: protoText(&n.Text)})
+		}
+	}
+	return m
+}
+
+func (e *protoEncoder) services(services *[]cydx.Service) []*v1_6.Service {
+	if services == nil {
+		return nil
+	}
+	ms := make([]*v1_6.Service, 0, len(*services))
+	for i := range *services {
+		s := &(*services)[i]
+		m := &v1_6.Service{
+			BomRef:             protoString(s.BOMRef),
+			Provider:           protoEntity(s.Provider),
+			Group:              protoString(s.Group),
+			Name:               s.Name,
+			Version:            protoString(s.Version),
+			Description:        protoString(s.Description),
+			Endpoints:          protoStrings(s.Endpoints),
+			Authenticated:      s.Authenticated,
+			XTrustBoundary:     s.CrossesTrustBoundary,
+			Licenses:           e.licenses(s.Licenses),
+			ExternalReferences: e.externalReferences(s.ExternalReferences),
+			Services:           e.services(s.Services),
+			Properties:         protoProperties(s.Properties),
+			ReleaseNotes:       e.releaseNotes(s.ReleaseNotes),
+		}
+		if s.Data != nil {
+			for _, d := range *s.Data {
+				flow, _ := protoEnum[v1_6.DataFlowDirection](e, v1_6.DataFlowDirection_value, "DATA_FLOW_", string(d.Flow))
+				m.Data = append(m.Data, &v1_6.DataFlow{Flow: flow, Value: d.Classification})
+			}
+		}
+		ms = append(ms, m)
+	}
+	return ms
+}
+
+func (e *protoEncoder) hashes(hashes *[]cydx.Hash) []*v1_6.Hash {
+	if hashes == nil {
+		return nil
+	}
+	ms := make([]*v1_6.Hash, 0, len(*hashes))
+	for _, h := range *hashes {
+		alg, _ := protoEnum[v1_6.HashAlg](e, v1_6.HashAlg_value, "HASH_ALG_", string(h.Algorithm))
+		ms = append(ms, &v1_6.Hash{Alg: alg, Value: h.Value})
+	}
+	return ms
+}
+
+func (e *protoEncoder) licenses(licenses *cydx.Licenses) []*v1_6.LicenseChoice {
+	if licenses == nil {
+		return nil
+	}
+	ms := make([]*v1_6.LicenseChoice, 0, len(*licenses))
+	for _, lc := range *licenses {
+		switch {
+		case lc.License != nil:
+			l := lc.License
+			e.drop("license licensing", l.Licensing != nil)
+
+			lm := &v1_6.License{
+				Text:       protoText(l.Text),
+				Url:        protoString(l.URL),
+				BomRef:     protoString(l.BOMRef),
+				Properties: protoProperties(l.Properties),
+			}
+			if l.ID != "" {
+				lm.License = &v1_6.License_Id{Id: l.ID}
+			} else {
+				lm.License = &v1_6.License_Name{Name: l.Name}
+			}
+			if ack, ok := protoEnum[v1_6.LicenseAcknowledgementEnumeration](e, v1_6.LicenseAcknowledgementEnumeration_value, "LICENSE_ACKNOWLEDGEMENT_ENUMERATION_", string(l.Acknowledgement)); ok {
+				lm.Acknowledgement = &ack
+			}
+			ms = append(ms, &v1_6.LicenseChoice{Choice: &v1_6.LicenseChoice_License{License: lm}})
+		case lc.Expression != "":
+			ms = append(ms, &v1_6.LicenseChoice{Choice: &v1_6.LicenseChoice_Expression{Expression: lc.Expression}})
+		}
+	}
+	return ms
+}
+
+func (e *protoEncoder) externalReferences(refs *[]cydx.ExternalReference) []*v1_6.ExternalReference {
+	if refs == nil {
+		return nil
+	}
+	ms := make([]*v1_6.ExternalReference, 0, len(*refs))
+	for _, r := range *refs {
+		typ, _ := protoEnum[v1_6.ExternalReferenceType](e, v1_6.ExternalReferenceType_value, "EXTERNAL_REFERENCE_TYPE_", string(r.Type))
+		ms = append(ms, &v1_6.ExternalReference{
+			Type:    typ,
+			Url:     r.URL,
+			Comment: protoString(r.Comment),
+			Hashes:  e.hashes(r.Hashes),
+		})
+	}
+	return ms
+}
+
+// timestamp parses a timestamp of the bom, timestamps which can not be read
+// are counted as dropped
+func (e *protoEncoder) timestamp(ts string) *timestamppb.Timestamp {
+	if ts == "" {
+		return nil
+	}
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, ts); err == nil {
+			return timestamppb.New(t)
+		}
+	}
+	e.drop(fmt.Sprintf("timestamp %q", ts), true)
+	return nil
+}
+
+// downgrade copies the 1.6 bom into the Bom message of an older spec version
+func (e *protoEncoder) downgrade(src *v1_6.Bom, dst proto.Message) proto.Message {
+	// before 1.5 the metadata holds a list of legacy tools
+	metadata := dst.ProtoReflect().Descriptor().Fields().ByName("metadata")
+	toolsField := metadata.Message().Fields().ByName("tools")
+	tools := src.GetMetadata().GetTools()
+	if toolsField.IsList() && tools != nil {
+		src.Metadata.Tools = nil
+	}
+
+	protoCopy(src.ProtoReflect(), dst.ProtoReflect(), e.dropped)
+
+	if !toolsField.IsList() || tools == nil {
+		return dst
+	}
+	e.drop("tool services", len(tools.Services) > 0)
+	list := dst.ProtoReflect().Mutable(metadata).Message().Mutable(toolsField).List()
+	for _, c := range tools.Components {
+		t := &v1_6.Tool{
+			Vendor:             c.Publisher,
+			Name:               proto.String(c.Name),
+			Version:            proto.String(c.Version),
+			Hashes:             c.Hashes,
+			ExternalReferences: c.ExternalReferences,
+		}
+		el := list.NewElement()
+		protoCopy(t.ProtoReflect(), el.Message(), e.dropped)
+		list.Append(el)
+	}
+	return dst
+}
+
+// protoCopy copies the fields of src into dst by their name in the schema.
+// Fields dst does not define or with another kind are counted as dropped, a
+// list is copied into a singular field by its first element. Enum values are
+// matched by their name.
+func protoCopy(src, dst protoreflect.Message, dropped map[string]int) {
+	fields := dst.Descriptor().Fields()
+	src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		to := fields.ByName(fd.Name())
+		if to == nil || to.Kind() != fd.Kind() || fd.IsMap() || to.IsMap() {
+			dropped[protoFieldName(fd)]++
+			return true
+		}
+
+		values := []protoreflect.Value{v}
+		if fd.IsList() {
+			values = values[:0]
+			for i := 0; i < v.List().Len(); i++ {
+				values = append(values, v.List().Get(i))
+			}
+		}
+		if !to.IsList() && len(values) > 1 {
+			dropped[protoFieldName(fd)] += len(values) - 1
+			values = values[:1]
+		}
+
+		var list protoreflect.List
+		if to.IsList() {
+			list = dst.Mutable(to).List()
+		}
+		for _, value := range values {
+			var el protoreflect.Value
+			if list != nil {
+				el = list.NewElement()
+			} else {
+				el = dst.NewField(to)
+			}
+			switch to.Kind() {
+			case protoreflect.MessageKind, protoreflect.GroupKind:
+				protoCopy(value.Message(), el.Message(), dropped)
+			case protoreflect.EnumKind:
+				name := fd.Enum().Values().ByNumber(value.Enum()).Name()
+				n := to.Enum().Values().ByName(name)
+				if n == nil {
+					dropped[protoFieldName(fd)+" "+string(name)]++
+					continue
+				}
+				el = protoreflect.ValueOfEnum(n.Number())
+			default:
+				el = value
+			}
+			if list != nil {
+				list.Append(el)
+			} else {
+				dst.Set(to, el)
+			}
+		}
+		return true
+	})
+}
+
+// protoFieldName names a field for the warning, as in external reference type
+func protoFieldName(fd protoreflect.FieldDescriptor) string {
+	var b strings.Builder
+	for i, r := range string(fd.ContainingMessage().Name()) {
+		if i > 0 && unicode.IsUpper(r) {
+			b.WriteByte(' ')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String() + " " + strings.ReplaceAll(string(fd.Name()), "_", " ")
+}
+
+// protoEnum looks up a CycloneDX enumeration value in the generated enum of
+// the prefix. Values which are not defined are counted as dropped and give the
+// zero value.
+func protoEnum[E ~int32](e *protoEncoder, values map[string]int32, prefix, value string) (E, bool) {
+	if value == "" {
+		return 0, false
+	}
+	n, ok := values[protoEnumName(prefix, value)]
+	e.drop(fmt.Sprintf("%s %q", strings.ToLower(strings.ReplaceAll(strings.TrimSuffix(prefix, "_"), "_", " ")), value), !ok)
+	return E(n), ok
+}
+
+// protoEnumName is the name of an enumeration value in the protobuf schema,
+// the words and numbers of the value become upper case parts joined by _, as
+// in HASH_ALG_SHA_3_256 for SHA3-256
+func protoEnumName(prefix, value string) string {
+	var b strings.Builder
+	b.WriteString(prefix)
+	var prev rune
+	for i, r := range value {
+		switch {
+		case r == '-' || r == '_' || r == ' ':
+			r = '_'
+		case i > 0 && prev != '_' && (unicode.IsDigit(r) != unicode.IsDigit(prev) || unicode.IsUpper(r) && unicode.IsLower(prev)):
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(r))
+		prev = r
+	}
+	return b.String()
+}
+
+func protoEntity(e *cydx.OrganizationalEntity) *v1_6.OrganizationalEntity {
+	if e == nil {
+		return nil
+	}
+	m := &v1_6.OrganizationalEntity{
+		Name:    protoString(e.Name),
+		Url:     protoStrings(e.URL),
+		Contact: protoContacts(e.Contact),
+		BomRef:  protoString(e.BOMRef),
+	}
+	if a := e.Address; a != nil {
+		m.Address = &v1_6.PostalAddressType{
+			BomRef:              protoString(a.BOMRef),
+			Country:             protoString(a.Country),
+			Region:              protoString(a.Region),
+			Locality:            protoString(a.Locality),
+			PostOfficeBoxNumber: protoString(a.PostOfficeBoxNumber),
+			PostalCodeue:        protoString(a.PostalCode),
+			StreetAddress:       protoString(a.StreetAddress),
+		}
+	}
+	return m
+}
+
+func protoContacts(contacts *[]cydx.OrganizationalContact) []*v1_6.OrganizationalContact {
+	if contacts == nil {
+		return nil
+	}
+	ms := make([]*v1_6.OrganizationalContact, 0, len(*contacts))
+	for _, c := range *contacts {
+		ms = append(ms, &v1_6.OrganizationalContact{
+			Name:   protoString(c.Name),
+			Email:  protoString(c.Email),
+			Phone:  protoString(c.Phone),
+			BomRef: protoString(c.BOMRef),
+		})
+	}
+	return ms
+}
+
+func protoSwid(s *cydx.SWID) *v1_6.Swid {
+	if s == nil {
+		return nil
+	}
+	m := &v1_6.Swid{
+		TagId:   s.TagID,
+		Name:    s.Name,
+		Version: protoString(s.Version),
+		Patch:   s.Patch,
+		Text:    protoText(s.Text),
+		Url:     protoString(s.URL),
+	}
+	if s.TagVersion != nil {
+		m.TagVersion = proto.Int32(int32(*s.TagVersion))
+	}
+	return m
+}
+
+func protoText(t *cydx.AttachedText) *v1_6.AttachedText {
+	if t == nil {
+		return nil
+	}
+	return &v1_6.AttachedText{
+		ContentType: protoString(t.ContentType),
+		Encoding:    protoString(t.Encoding),
+		Value:       t.Content,
+	}
+}
+
+func protoProperties(props *[]cydx.Property) []*v1_6.Property {
+	if props == nil {
+		return nil
+	}
+	ms := make([]*v1_6.Property, 0, len(*props))
+	for _, p := range *props {
+		ms = append(ms, &v1_6.Property{Name: p.Name, Value: protoString(p.Value)})
+	}
+	return ms
+}
+
+func protoRefs(refs *[]cydx.BOMReference) []string {
+	if refs == nil {
+		return nil
+	}
+	return lo.Map(*refs, func(r cydx.BOMReference, _ int) string { return string(r) })
+}
+
+func protoStrings(v *[]string) []string {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
+
+// protoString is nil for an empty string, which is left out like in json
+func protoString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,90 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdx
+
+import (
+	"testing"
+
+	cydx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/cdx/proto/v1_6"
+)
+
+func TestProtoEnumName(t *testing.T) {
+	tests := []struct {
+		prefix string
+		value  string
+		want   string
+	}{
+		{"HASH_ALG_", "MD5", "HASH_ALG_MD_5"},
+		{"HASH_ALG_", "SHA-256", "HASH_ALG_SHA_256"},
+		{"HASH_ALG_", "SHA3-512", "HASH_ALG_SHA_3_512"},
+		{"HASH_ALG_", "BLAKE2b-384", "HASH_ALG_BLAKE_2_B_384"},
+		{"CLASSIFICATION_", "machine-learning-model", "CLASSIFICATION_MACHINE_LEARNING_MODEL"},
+		{"EXTERNAL_REFERENCE_TYPE_", "rfc-9116", "EXTERNAL_REFERENCE_TYPE_RFC_9116"},
+		{"EVIDENCE_FIELD_", "omniborId", "EVIDENCE_FIELD_OMNIBOR_ID"},
+		{"AGGREGATE_", "incomplete_first_party_only", "AGGREGATE_INCOMPLETE_FIRST_PARTY_ONLY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := protoEnumName(tt.prefix, tt.value); got != tt.want {
+				t.Errorf("protoEnumName(%q, %q) = %s, want %s", tt.prefix, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestProtoEnumValues expects every value cyclonedx-go defines for 1.6 to be
+// found in the generated enums.
+func TestProtoEnumValues(t *testing.T) {
+	e := &protoEncoder{dropped: map[string]int{}}
+
+	for _, v := range []cydx.ComponentType{
+		cydx.ComponentTypeApplication, cydx.ComponentTypeContainer, cydx.ComponentTypeCryptographicAsset,
+		cydx.ComponentTypeData, cydx.ComponentTypeDevice, cydx.ComponentTypeDeviceDriver, cydx.ComponentTypeFile,
+		cydx.ComponentTypeFirmware, cydx.ComponentTypeFramework, cydx.ComponentTypeLibrary,
+		cydx.ComponentTypeMachineLearningModel, cydx.ComponentTypeOS, cydx.ComponentTypePlatform,
+	} {
+		protoEnum[v1_6.Classification](e, v1_6.Classification_value, "CLASSIFICATION_", string(v))
+	}
+	for _, v := range []cydx.HashAlgorithm{
+		cydx.HashAlgoMD5, cydx.HashAlgoSHA1, cydx.HashAlgoSHA256, cydx.HashAlgoSHA384, cydx.HashAlgoSHA512,
+		cydx.HashAlgoSHA3_256, cydx.HashAlgoSHA3_384, cydx.HashAlgoSHA3_512,
+		cydx.HashAlgoBlake2b_256, cydx.HashAlgoBlake2b_384, cydx.HashAlgoBlake2b_512, cydx.HashAlgoBlake3,
+	} {
+		protoEnum[v1_6.HashAlg](e, v1_6.HashAlg_value, "HASH_ALG_", string(v))
+	}
+	for _, v := range []cydx.ExternalReferenceType{
+		cydx.ERTypeAdversaryModel, cydx.ERTypeAdvisories, cydx.ERTypeAttestation, cydx.ERTypeBOM,
+		cydx.ERTypeBuildMeta, cydx.ERTypeBuildSystem, cydx.ERTypeCertificationReport, cydx.ERTypeChat,
+		cydx.ERTypeCodifiedInfrastructure, cydx.ERTypeComponentAnalysisReport, cydx.ERTypeConfiguration,
+		cydx.ERTypeDistribution, cydx.ERTypeDistributionIntake, cydx.ERTypeDocumentation,
+		cydx.ERTypeDynamicAnalysisReport, cydx.ERTypeEvidence, cydx.ERTypeExploitabilityStatement,
+		cydx.ERTypeFormulation, cydx.ERTypeIssueTracker, cydx.ERTypeLicense, cydx.ERTypeLog,
+		cydx.ERTypeMailingList, cydx.ERTypeMaturityReport, cydx.ERTypeModelCard, cydx.ERTypeOther,
+		cydx.ERTypePentestReport, cydx.ERTypeQualityMetrics, cydx.ERTypeReleaseNotes, cydx.ERTypeRiskAssessment,
+		cydx.ERTypeRuntimeAnalysisReport, cydx.ERTypeSecurityContact, cydx.ERTypeSocial,
+		cydx.ERTypeStaticAnalysisReport, cydx.ERTypeSupport, cydx.ERTypeThreatModel, cydx.ERTypeVCS,
+		cydx.ERTypeVulnerabilityAssertion, cydx.ERTypeWebsite,
+	} {
+		protoEnum[v1_6.ExternalReferenceType](e, v1_6.ExternalReferenceType_value, "EXTERNAL_REFERENCE_TYPE_", string(v))
+	}
+
+	for part := range e.dropped {
+		t.Errorf("%s is not in bom-1.6.proto", part)
+	}
+}
@@ -0,0 +1,556 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdx
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	cydx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/filter"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/report"
+	"github.com/interlynk-io/sbomasm/pkg/logger"
+	"github.com/interlynk-io/sbomasm/pkg/progress"
+	"github.com/samber/lo"
+)
+
+// number of components converted to the output spec version at once
+const streamBatchSize = 500
+
+// errStopScan ends a scan of an sbom once the handled fields were read.
+var errStopScan = errors.New("stop scan")
+
+// streamMerge is a flat merge of CycloneDX JSON sboms which never holds a
+// whole sbom in memory. Each input is read three times, for its metadata, its
+// components, which are written out as they are read, and its dependencies.
+// Only the refs of the unique components are kept, so licenses and external
+// references of duplicates are not merged into the kept component.
+type streamMerge struct {
+	merge
+
+	cs  *uniqueComponentService
+	w   *bufio.Writer
+	enc []json.RawMessage
+
+	components int
+	// number of components and dependencies of each input
+	inputs map[string]*report.Input
+}
+
+func newStreamMerge(ms *MergeSettings) *streamMerge {
+	cs := newUniqueComponentService(*ms.Ctx, ms.Assemble.DedupBy)
+	cs.report = ms.Report
+	cs.lowMemory = true
+
+	return &streamMerge{
+		merge:  *newMerge(ms),
+		cs:     cs,
+		inputs: make(map[string]*report.Input),
+	}
+}
+
+func (m *streamMerge) streamedMerge() error {
+	log := logger.FromContext(*m.settings.Ctx)
+
+	var output io.Writer
+	var sb strings.Builder
+
+	if m.settings.Output.Upload {
+		output = &sb
+	} else if m.settings.Output.Writer != nil {
+		output = m.settings.Output.Writer
+	} else {
+		output = os.Stdout
+	}
+	m.w = bufio.NewWriter(output)
+
+	for _, path := range m.settings.Input.Files {
+		m.inputs[path] = &report.Input{File: path, Spec: "cyclonedx", Format: "json"}
+	}
+
+	log.Debugf("low memory: reading metadata of %d sboms", len(m.settings.Input.Files))
+	priComps, tools, err := m.readMetadata()
+	if err != nil {
+		return err
+	}
+
+	if err := m.precheck(priComps); err != nil {
+		return err
+	}
+
+	m.initOutBom()
+	m.out.Metadata.Component = m.setupPrimaryComp()
+	m.out.Metadata.Tools = tools
+	m.out.Metadata.Properties = &[]cydx.Property{
+		{Name: "sbomasm:dedup_by", Value: m.settings.Assemble.DedupBy},
+		{Name: "sbomasm:low_memory", Value: "true"},
+	}
+
+	if err := m.writeHeader(); err != nil {
+		return err
+	}
+
+	if _, err := m.w.WriteString(",\n  \"components\": [\n"); err != nil {
+		return err
+	}
+
+	for _, c := range priComps {
+		if err := m.addComponent(*c); err != nil {
+			return err
+		}
+	}
+
+	log.Debugf("low memory: streaming components")
+	p := progress.FromContext(*m.settings.Ctx)
+	p.Start("merging sboms", len(m.settings.Input.Files))
+	defer p.Done()
+	for _, path := range m.settings.Input.Files {
+		if err := (*m.settings.Ctx).Err(); err != nil {
+			return err
+		}
+
+		m.cs.setInput(path)
+		err := scanBom(path, map[string]func(*json.Decoder) error{
+			"components": func(dec *json.Decoder) error {
+				return decodeArray(dec, func(dec *json.Decoder) error {
+					var c cydx.Component
+					if err := dec.Decode(&c); err != nil {
+						return err
+					}
+					return m.streamComponent(path, &c)
+				})
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("reading components of %s: %w", path, err)
+		}
+		p.Step(filepath.Base(path))
+	}
+
+	if err := m.flushComponents(); err != nil {
+		return err
+	}
+
+	if err := checkLicenses(m.settings, m.cs); err != nil {
+		return err
+	}
+
+	log.Debugf("low memory: merging dependencies")
+	dm := newDependencyMerger()
+	for _, path := range m.settings.Input.Files {
+		m.cs.setInput(path)
+		err := scanBom(path, map[string]func(*json.Decoder) error{
+			"dependencies": func(dec *json.Decoder) error {
+				return decodeArray(dec, func(dec *json.Decoder) error {
+					var d cydx.Dependency
+					if err := dec.Decode(&d); err != nil {
+						return err
+					}
+					m.inputs[path].Dependencies += len(lo.FromPtr(d.Dependencies))
+					dm.add(d, m.cs)
+					return nil
+				})
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("reading dependencies of %s: %w", path, err)
+		}
+	}
+
+	deps := dm.list()
+	priCompIds := lo.Map(priComps, func(c *cydx.Component, _ int) string {
+		return c.BOMRef
+	})
+	deps = append(deps, cydx.Dependency{
+		Ref:          m.out.Metadata.Component.BOMRef,
+		Dependencies: &priCompIds,
+	})
+
+	// Components are streamed in input order, only their licenses and the
+	// dependencies are sorted
+	sortDependencies(&deps)
+	if err := m.writeDependencies(deps); err != nil {
+		return err
+	}
+
+	if _, err := m.w.WriteString("\n}\n"); err != nil {
+		return err
+	}
+	if err := m.w.Flush(); err != nil {
+		return err
+	}
+
+	if m.settings.Report != nil {
+		for _, path := range m.settings.Input.Files {
+			m.settings.Report.AddInput(*m.inputs[path])
+		}
+
+		s := &m.settings.Report.Summary
+		s.Spec = "cyclonedx"
+		s.SpecVersion = m.settings.Output.SpecVersion
+		s.MergeMode = "flat"
+		s.Inputs = len(m.settings.Input.Files)
+		s.Components = m.components
+		s.DuplicateComponents = m.cs.duplicates
+		s.Dependencies = countDependencies(&deps)
+		s.UnresolvedDependencies = dm.unresolved
+	}
+
+	if m.settings.Output.Upload {
+		return m.uploadToServer(sb.String())
+	}
+	return nil
+}
+
+// readMetadata returns the unique primary components and the tools of all
+// inputs.
+func (m *streamMerge) readMetadata() ([]*cydx.Component, *cydx.ToolsChoice, error) {
+	priComps := []*cydx.Component{}
+	boms := []*cydx.BOM{}
+
+	for _, path := range m.settings.Input.Files {
+		m.cs.setInput(path)
+
+		var md cydx.Metadata
+		err := scanBom(path, map[string]func(*json.Decoder) error{
+			"metadata": func(dec *json.Decoder) error {
+				if err := dec.Decode(&md); err != nil {
+					return err
+				}
+				return errStopScan
+			},
+		})
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading metadata of %s: %w", path, err)
+		}
+
+		if md.Component != nil {
+			m.inputs[path].Components += 1 + countComponents(md.Component.Components)
+			newComp, _ := m.cs.StoreAndCloneWithNewID(md.Component)
+			priComps = append(priComps, newComp)
+		}
+
+		md.Component = nil
+		boms = append(boms, &cydx.BOM{Metadata: &md})
+	}
+
+	return lo.Uniq(priComps), buildToolList(boms, m.settings.Build), nil
+}
+
+// precheck deduplicates the components of the inputs once before anything is
+// written, when the minimum number of components or strict licenses are
+// checked, so that an assembly failing them leaves no partial sbom behind.
+func (m *streamMerge) precheck(priComps []*cydx.Component) error {
+	if m.settings.Assemble.MinComponents == 0 && !m.settings.Assemble.StrictLicenses {
+		return nil
+	}
+
+	cs := newUniqueComponentService(*m.settings.Ctx, m.settings.Assemble.DedupBy)
+	cs.lowMemory = true
+
+	count := 0
+	for _, c := range priComps {
+		if _, duplicate := cs.StoreAndCloneWithNewID(c); !duplicate {
+			count++
+		}
+	}
+
+	var store func(c *cydx.Component)
+	store = func(c *cydx.Component) {
+		if _, duplicate := cs.StoreAndCloneWithNewID(c); !duplicate {
+			count++
+		}
+		for _, nc := range lo.FromPtr(c.Components) {
+			store(&nc)
+		}
+	}
+
+	for _, path := range m.settings.Input.Files {
+		if err := (*m.settings.Ctx).Err(); err != nil {
+			return err
+		}
+
+		cs.setInput(path)
+		err := scanBom(path, map[string]func(*json.Decoder) error{
+			"components": func(dec *json.Decoder) error {
+				return decodeArray(dec, func(dec *json.Decoder) error {
+					var c cydx.Component
+					if err := dec.Decode(&c); err != nil {
+						return err
+					}
+					store(&c)
+					return nil
+				})
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("reading components of %s: %w", path, err)
+		}
+	}
+
+	if err := filter.CheckMinComponents(count, m.settings.Assemble.MinComponents); err != nil {
+		return err
+	}
+	return checkLicenses(m.settings, cs)
+}
+
+// streamComponent stores c and its nested components, and queues the unique
+// ones to be written.
+func (m *streamMerge) streamComponent(path string, c *cydx.Component) error {
+	m.inputs[path].Components++
+
+	newComp, duplicate := m.cs.StoreAndCloneWithNewID(c)
+	if !duplicate {
+		newComp.Components = nil
+		if err := m.addComponent(*newComp); err != nil {
+			return err
+		}
+	}
+
+	for _, nc := range lo.FromPtr(c.Components) {
+		if err := m.streamComponent(path, &nc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addComponent queues c to be written, the queue is written once it is full.
+func (m *streamMerge) addComponent(c cydx.Component) error {
+	if m.out.Components == nil {
+		m.out.Components = &[]cydx.Component{}
+	}
+	*m.out.Components = append(*m.out.Components, c)
+
+	if len(*m.out.Components) >= streamBatchSize {
+		return m.flushComponents()
+	}
+	return nil
+}
+
+// flushComponents writes the queued components, encoded in the output spec
+// version.
+func (m *streamMerge) flushComponents() error {
+	comps := lo.FromPtr(m.out.Components)
+	if len(comps) == 0 {
+		return nil
+	}
+	m.out.Components = nil
+
+	for i := range comps {
+		sortLicenses(comps[i].Licenses)
+	}
+
+	bom := cydx.NewBOM()
+	bom.Components = &comps
+
+	var encoded struct {
+		Components []json.RawMessage `json:"components"`
+	}
+	if err := m.encode(bom, &encoded); err != nil {
+		return err
+	}
+
+	for _, raw := range encoded.Components {
+		if m.components > 0 {
+			if _, err := m.w.WriteString(",\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := m.w.WriteString("    "); err != nil {
+			return err
+		}
+		if _, err := m.w.Write(raw); err != nil {
+			return err
+		}
+		m.components++
+	}
+	return nil
+}
+
+// writeHeader writes the output sbom without components and dependencies,
+// leaving the top level object open.
+func (m *streamMerge) writeHeader() error {
+	var buf bytes.Buffer
+	if err := m.encodeTo(&buf, m.out); err != nil {
+		return err
+	}
+
+	header := bytes.TrimRight(buf.Bytes(), " \n")
+	header = bytes.TrimSuffix(header, []byte("}"))
+	header = bytes.TrimRight(header, " \n")
+
+	_, err := m.w.Write(header)
+	return err
+}
+
+func (m *streamMerge) writeDependencies(deps []cydx.Dependency) error {
+	if _, err := m.w.WriteString("\n  ],\n  \"dependencies\": [\n"); err != nil {
+		return err
+	}
+
+	for i, d := range deps {
+		b, err := json.MarshalIndent(d, "    ", "  ")
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if _, err := m.w.WriteString(",\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := m.w.WriteString("    "); err != nil {
+			return err
+		}
+		if _, err := m.w.Write(b); err != nil {
+			return err
+		}
+	}
+
+	_, err := m.w.WriteString("\n  ]")
+	return err
+}
+
+func (m *streamMerge) encode(bom *cydx.BOM, v interface{}) error {
+	var buf bytes.Buffer
+	if err := m.encodeTo(&buf, bom); err != nil {
+		return err
+	}
+	return json.Unmarshal(buf.Bytes(), v)
+}
+
+func (m *streamMerge) encodeTo(w io.Writer, bom *cydx.BOM) error {
+	encoder := cydx.NewBOMEncoder(w, cydx.BOMFileFormatJSON)
+	encoder.SetPretty(true)
+	encoder.SetEscapeHTML(true)
+
+	if m.settings.Output.SpecVersion == "" {
+		return encoder.Encode(bom)
+	}
+	return encoder.EncodeVersion(bom, specVersionMap[m.settings.Output.SpecVersion])
+}
+
+// scanBom reads the top level fields of the json sbom at path, the value of
+// each field found in handlers is passed to its handler which must consume it,
+// all other values are skipped. A handler returns errStopScan to end the scan.
+func scanBom(path string, handlers map[string]func(*json.Decoder) error) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	dec := json.NewDecoder(bufio.NewReader(f))
+
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := t.(string)
+		if !ok {
+			return fmt.Errorf("unexpected token %v", t)
+		}
+
+		handler, ok := handlers[key]
+		if !ok {
+			if err := skipValue(dec); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := handler(dec); err != nil {
+			if err == errStopScan {
+				return nil
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeArray calls fn for each element of the json array read from dec, a
+// null value is treated as an empty array.
+func decodeArray(dec *json.Decoder, fn func(*json.Decoder) error) error {
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if t == nil {
+		return nil
+	}
+	if d, ok := t.(json.Delim); !ok || d != '[' {
+		return fmt.Errorf("expected an array, found %v", t)
+	}
+
+	for dec.More() {
+		if err := fn(dec); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, ']')
+}
+
+// skipValue reads the next json value from dec, token by token, so that large
+// values are not held in memory.
+func skipValue(dec *json.Decoder) error {
+	depth := 0
+	for {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		if d, ok := t.(json.Delim); ok {
+			switch d {
+			case '{', '[':
+				depth++
+			case '}', ']':
+				depth--
+			}
+		}
+
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	t, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := t.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("expected %v, found %v", delim, t)
+	}
+	return nil
+}
@@ -20,41 +20,136 @@ package cdx
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	cydx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/dedup"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/report"
+	"github.com/samber/lo"
 )
 
 type uniqueComponentService struct {
 	ctx context.Context
+	// strategy decides which components are the same, see the dedup package
+	strategy string
 	//unique list of new components
 	compMap map[string]*cydx.Component
 
-	//mapping from old component id to new component id
+	//mapping from old component id to new component id, scoped by input file
+	//as bom-refs are only unique within an sbom
 	idMap map[string]string
+
+	// number of components which were merged into an existing one
+	duplicates int
+
+	// lowMemory only keeps the ref and licenses of unique components, which
+	// is enough to resolve refs and report duplicates. Duplicates are not
+	// merged into the kept component as it may already have been written.
+	lowMemory bool
+
+	// report records the duplicates, file is the input currently processed
+	// and firstSeen the input each unique component was first found in
+	report    *report.Report
+	file      string
+	firstSeen map[string]string
+
+	// inputs numbers the input files in the order they are first set, refs
+	// are derived from it and used guards against refs derived twice
+	inputs map[string]int
+	used   map[string]bool
+	unref  int
+
+	// unmapped holds the licenses, prefixed by their component, which could
+	// not be mapped to spdx ids
+	unmapped map[string]bool
+
+	// nested holds the unique nested components of components stored as a
+	// tree, by the ref of their parent, inTree the refs nested this way
+	nested map[string][]*cydx.Component
+	inTree map[string]bool
 }
 
-func newUniqueComponentService(ctx context.Context) *uniqueComponentService {
+func newUniqueComponentService(ctx context.Context, strategy string) *uniqueComponentService {
 	return &uniqueComponentService{
-		ctx:     ctx,
-		compMap: make(map[string]*cydx.Component),
-		idMap:   make(map[string]string),
+		ctx:      ctx,
+		strategy: strategy,
+		compMap:  make(map[string]*cydx.Component),
+		idMap:    make(map[string]string),
+
+		firstSeen: make(map[string]string),
+		inputs:    make(map[string]int),
+		used:      make(map[string]bool),
+		unmapped:  make(map[string]bool),
+		nested:    make(map[string][]*cydx.Component),
+		inTree:    make(map[string]bool),
+	}
+}
+
+// setInput sets the input file the following components are read from and
+// refs are resolved in.
+func (s *uniqueComponentService) setInput(file string) {
+	s.file = file
+	if _, ok := s.inputs[file]; !ok {
+		s.inputs[file] = len(s.inputs)
 	}
 }
 
+// newID derives the ref of a unique component from its dedup key, otherwise
+// from its ref in the input, so that the same inputs give the same refs.
+func (s *uniqueComponentService) newID(lookupKey, oldID string) string {
+	seed := ""
+	switch {
+	case lookupKey != "":
+		seed = "key:" + lookupKey
+	case oldID != "":
+		seed = fmt.Sprintf("ref:%d#%s", s.inputs[s.file], oldID)
+	default:
+		s.unref++
+		seed = fmt.Sprintf("unref:%d", s.unref)
+	}
+
+	id := newBomRef(seed)
+	for n := 1; s.used[id]; n++ {
+		id = newBomRef(fmt.Sprintf("%s#%d", seed, n))
+	}
+	s.used[id] = true
+	return id
+}
+
+func (s *uniqueComponentService) scopedID(id string) string {
+	return fmt.Sprintf("%s#%s", s.file, id)
+}
+
 func (s *uniqueComponentService) StoreAndCloneWithNewID(c *cydx.Component) (*cydx.Component, bool) {
 	if c == nil {
 		return nil, false
 	}
 
-	lookupKey := fmt.Sprintf("%s-%s-%s",
-		strings.ToLower(string(c.Type)),
-		strings.ToLower(c.Name),
-		strings.ToLower(c.Version))
+	s.normalizeLicenses(c)
 
-	if foundComp, ok := s.compMap[lookupKey]; ok {
-		if c.BOMRef != foundComp.BOMRef {
-			s.idMap[c.BOMRef] = foundComp.BOMRef
+	lookupKey, keyType := dedup.Key(s.strategy, dedup.Identity{
+		Purl:    c.PackageURL,
+		CPE:     c.CPE,
+		Type:    string(c.Type),
+		Group:   c.Group,
+		Name:    c.Name,
+		Version: c.Version,
+	})
+	// a synthesized primary component stands for its input, it is never the
+	// duplicate of another one
+	if strings.HasPrefix(c.BOMRef, synthesizedRefPrefix) {
+		lookupKey = ""
+	}
+
+	if foundComp, ok := s.compMap[lookupKey]; ok && lookupKey != "" {
+		s.duplicates++
+		s.recordDuplicate(lookupKey, keyType, c, foundComp)
+		if !s.lowMemory {
+			unionComp(foundComp, c)
+		}
+		if c.BOMRef != "" {
+			s.idMap[s.scopedID(c.BOMRef)] = foundComp.BOMRef
 		}
 		return foundComp, true
 	}
@@ -64,16 +159,88 @@ func (s *uniqueComponentService) StoreAndCloneWithNewID(c *cydx.Component) (*cyd
 		panic(err)
 	}
 
-	newID := newBomRef()
+	newID := s.newID(lookupKey, c.BOMRef)
 	nc.BOMRef = newID
 
-	s.compMap[lookupKey] = nc
-	s.idMap[c.BOMRef] = newID
+	if lookupKey != "" {
+		if s.lowMemory {
+			s.compMap[lookupKey] = &cydx.Component{BOMRef: nc.BOMRef, Licenses: nc.Licenses}
+		} else {
+			s.compMap[lookupKey] = nc
+		}
+		s.firstSeen[lookupKey] = s.file
+	}
+	if c.BOMRef != "" {
+		s.idMap[s.scopedID(c.BOMRef)] = newID
+	}
 	return nc, false
 }
 
+// StoreTreeAndCloneWithNewID stores c along with its nested components, so
+// that the refs at every level of the tree are rewritten and can not collide
+// with refs of other levels or sboms. A nested component which duplicates one
+// stored before is dropped from the tree, it stays where it was first found,
+// and the nested components of a duplicate are added to the kept one. Tree
+// returns the kept tree once all sboms are stored.
+func (s *uniqueComponentService) StoreTreeAndCloneWithNewID(c *cydx.Component) (*cydx.Component, bool) {
+	nc, duplicate := s.StoreAndCloneWithNewID(c)
+	if nc == nil {
+		return nil, false
+	}
+	if !duplicate {
+		nc.Components = nil
+	}
+
+	for i := range lo.FromPtr(c.Components) {
+		child, dup := s.StoreTreeAndCloneWithNewID(&(*c.Components)[i])
+		if !dup {
+			s.nested[nc.BOMRef] = append(s.nested[nc.BOMRef], child)
+			s.inTree[child.BOMRef] = true
+		}
+	}
+	return nc, duplicate
+}
+
+// IsNested reports whether the stored component is nested in the tree of
+// another one.
+func (s *uniqueComponentService) IsNested(c *cydx.Component) bool {
+	return s.inTree[c.BOMRef]
+}
+
+// Tree returns a copy of the stored component with its unique nested
+// components.
+func (s *uniqueComponentService) Tree(c *cydx.Component) cydx.Component {
+	t := *c
+	if children, ok := s.nested[c.BOMRef]; ok {
+		comps := lo.Map(children, func(child *cydx.Component, _ int) cydx.Component {
+			return s.Tree(child)
+		})
+		t.Components = &comps
+	}
+	return t
+}
+
+// normalizeLicenses maps the licenses of c and its nested components to spdx
+// ids, so that duplicates compare and merge them by id.
+func (s *uniqueComponentService) normalizeLicenses(c *cydx.Component) {
+	for _, l := range normalizeLicenses(c.Licenses) {
+		s.unmapped[fmt.Sprintf("%s@%s: %s", c.Name, c.Version, l)] = true
+	}
+	for i := range lo.FromPtr(c.Components) {
+		s.normalizeLicenses(&(*c.Components)[i])
+	}
+}
+
+// UnmappedLicenses returns the sorted licenses which could not be mapped to
+// spdx ids, prefixed by their component.
+func (s *uniqueComponentService) UnmappedLicenses() []string {
+	unmapped := lo.Keys(s.unmapped)
+	sort.Strings(unmapped)
+	return unmapped
+}
+
 func (s *uniqueComponentService) ResolveDepID(depID string) (string, bool) {
-	if newID, ok := s.idMap[depID]; ok {
+	if newID, ok := s.idMap[s.scopedID(depID)]; ok {
 		return newID, true
 	}
 	return "", false
@@ -82,9 +249,107 @@ func (s *uniqueComponentService) ResolveDepID(depID string) (string, bool) {
 func (s *uniqueComponentService) ResolveDepIDs(depIDs []string) []string {
 	ids := make([]string, 0, len(depIDs))
 	for _, depID := range depIDs {
-		if newID, ok := s.idMap[depID]; ok {
+		if newID, ok := s.idMap[s.scopedID(depID)]; ok {
 			ids = append(ids, newID)
 		}
 	}
 	return ids
 }
+
+func (s *uniqueComponentService) recordDuplicate(key, keyType string, dup, kept *cydx.Component) {
+	if s.report == nil {
+		return
+	}
+
+	s.report.AddDuplicate(report.Duplicate{
+		Name:      dup.Name,
+		Version:   dup.Version,
+		KeyType:   keyType,
+		Key:       key,
+		File:      s.file,
+		FirstSeen: s.firstSeen[key],
+		Ref:       kept.BOMRef,
+	})
+
+	dupLicenses := licenseStrings(dup.Licenses)
+	keptLicenses := licenseStrings(kept.Licenses)
+	if len(dupLicenses) > 0 && !(lo.Every(dupLicenses, keptLicenses) && lo.Every(keptLicenses, dupLicenses)) {
+		s.report.AddLicenseConflict(report.LicenseConflict{
+			Name:     dup.Name,
+			Version:  dup.Version,
+			Key:      key,
+			File:     s.file,
+			Kept:     keptLicenses,
+			Conflict: dupLicenses,
+		})
+	}
+}
+
+// unionComp adds the licenses, hashes, external references and properties of
+// dup, which are not already present, to kept. A hash is only added when kept
+// has none of its algorithm. Kept takes the scope of dup when it is more
+// required, so that a component is filtered by its most required duplicate.
+func unionComp(kept, dup *cydx.Component) {
+	if scopeRank(dup.Scope) > scopeRank(kept.Scope) {
+		kept.Scope = dup.Scope
+	}
+
+	keptLicenses := licenseStrings(kept.Licenses)
+	for _, l := range lo.FromPtr(dup.Licenses) {
+		ls := licenseStrings(&cydx.Licenses{l})
+		if len(ls) == 0 || lo.Contains(keptLicenses, ls[0]) {
+			continue
+		}
+		if kept.Licenses == nil {
+			kept.Licenses = &cydx.Licenses{}
+		}
+		*kept.Licenses = append(*kept.Licenses, l)
+		keptLicenses = append(keptLicenses, ls[0])
+	}
+	if kept.Licenses != nil {
+		*kept.Licenses = combineLicenses(*kept.Licenses)
+	}
+
+	refKey := func(r cydx.ExternalReference) string {
+		return fmt.Sprintf("%s-%s", r.Type, r.URL)
+	}
+	keptRefs := lo.Map(lo.FromPtr(kept.ExternalReferences), func(r cydx.ExternalReference, _ int) string {
+		return refKey(r)
+	})
+	for _, r := range lo.FromPtr(dup.ExternalReferences) {
+		if lo.Contains(keptRefs, refKey(r)) {
+			continue
+		}
+		if kept.ExternalReferences == nil {
+			kept.ExternalReferences = &[]cydx.ExternalReference{}
+		}
+		*kept.ExternalReferences = append(*kept.ExternalReferences, r)
+		keptRefs = append(keptRefs, refKey(r))
+	}
+
+	keptAlgos := lo.Map(lo.FromPtr(kept.Hashes), func(h cydx.Hash, _ int) cydx.HashAlgorithm {
+		return h.Algorithm
+	})
+	for _, h := range lo.FromPtr(dup.Hashes) {
+		if lo.Contains(keptAlgos, h.Algorithm) {
+			continue
+		}
+		if kept.Hashes == nil {
+			kept.Hashes = &[]cydx.Hash{}
+		}
+		*kept.Hashes = append(*kept.Hashes, h)
+		keptAlgos = append(keptAlgos, h.Algorithm)
+	}
+
+	keptProps := lo.FromPtr(kept.Properties)
+	for _, p := range lo.FromPtr(dup.Properties) {
+		if lo.Contains(keptProps, p) {
+			continue
+		}
+		if kept.Properties == nil {
+			kept.Properties = &[]cydx.Property{}
+		}
+		*kept.Properties = append(*kept.Properties, p)
+		keptProps = append(keptProps, p)
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cdx
+
+import (
+	"reflect"
+	"testing"
+
+	cydx "github.com/CycloneDX/cyclonedx-go"
+)
+
+func TestUnionComp(t *testing.T) {
+	sha1 := cydx.Hash{Algorithm: cydx.HashAlgoSHA1, Value: "da39a3ee5e6b4b0d3255bfef95601890afd80709"}
+	sha256 := cydx.Hash{Algorithm: cydx.HashAlgoSHA256, Value: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"}
+	otherSha256 := cydx.Hash{Algorithm: cydx.HashAlgoSHA256, Value: "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"}
+	website := cydx.ExternalReference{Type: cydx.ERTypeWebsite, URL: "https://example.com"}
+	vcs := cydx.ExternalReference{Type: cydx.ERTypeVCS, URL: "https://example.com/foo.git"}
+	mit := cydx.LicenseChoice{License: &cydx.License{ID: "MIT"}}
+	apache := cydx.LicenseChoice{License: &cydx.License{ID: "Apache-2.0"}}
+	custom := cydx.LicenseChoice{License: &cydx.License{Name: "Custom"}}
+	origin := cydx.Property{Name: "origin", Value: "a"}
+	otherOrigin := cydx.Property{Name: "origin", Value: "b"}
+
+	tests := []struct {
+		name string
+		kept cydx.Component
+		dup  cydx.Component
+		want cydx.Component
+	}{
+		{
+			name: "hashes are added",
+			kept: cydx.Component{Hashes: &[]cydx.Hash{sha1}},
+			dup:  cydx.Component{Hashes: &[]cydx.Hash{sha256}},
+			want: cydx.Component{Hashes: &[]cydx.Hash{sha1, sha256}},
+		},
+		{
+			name: "hashes are deduplicated by algorithm",
+			kept: cydx.Component{Hashes: &[]cydx.Hash{sha256}},
+			dup:  cydx.Component{Hashes: &[]cydx.Hash{otherSha256, sha1}},
+			want: cydx.Component{Hashes: &[]cydx.Hash{sha256, sha1}},
+		},
+		{
+			name: "hashes of a component without any",
+			dup:  cydx.Component{Hashes: &[]cydx.Hash{sha256}},
+			want: cydx.Component{Hashes: &[]cydx.Hash{sha256}},
+		},
+		{
+			name: "properties are added once",
+			kept: cydx.Component{Properties: &[]cydx.Property{origin}},
+			dup:  cydx.Component{Properties: &[]cydx.Property{origin, otherOrigin}},
+			want: cydx.Component{Properties: &[]cydx.Property{origin, otherOrigin}},
+		},
+		{
+			name: "licenses are added once",
+			kept: cydx.Component{Licenses: &cydx.Licenses{mit}},
+			dup:  cydx.Component{Licenses: &cydx.Licenses{mit, apache}},
+			want: cydx.Component{Licenses: &cydx.Licenses{mit, apache}},
+		},
+		{
+			name: "license expressions are joined with AND",
+			kept: cydx.Component{Licenses: &cydx.Licenses{{Expression: "MIT OR Apache-2.0"}}},
+			dup:  cydx.Component{Licenses: &cydx.Licenses{{Expression: "BSD-3-Clause"}}},
+			want: cydx.Component{Licenses: &cydx.Licenses{{Expression: "(MIT OR Apache-2.0) AND BSD-3-Clause"}}},
+		},
+		{
+			name: "license names are kept",
+			kept: cydx.Component{Licenses: &cydx.Licenses{mit}},
+			dup:  cydx.Component{Licenses: &cydx.Licenses{custom}},
+			want: cydx.Component{Licenses: &cydx.Licenses{mit, custom}},
+		},
+		{
+			name: "external references are added once",
+			kept: cydx.Component{ExternalReferences: &[]cydx.ExternalReference{website}},
+			dup:  cydx.Component{ExternalReferences: &[]cydx.ExternalReference{website, vcs}},
+			want: cydx.Component{ExternalReferences: &[]cydx.ExternalReference{website, vcs}},
+		},
+		{
+			name: "required scope wins over optional",
+			kept: cydx.Component{Scope: cydx.ScopeOptional},
+			dup:  cydx.Component{Scope: cydx.ScopeRequired},
+			want: cydx.Component{Scope: cydx.ScopeRequired},
+		},
+		{
+			name: "no scope is required",
+			kept: cydx.Component{Scope: cydx.ScopeExcluded},
+			dup:  cydx.Component{},
+			want: cydx.Component{},
+		},
+		{
+			name: "optional scope wins over excluded",
+			kept: cydx.Component{Scope: cydx.ScopeExcluded},
+			dup:  cydx.Component{Scope: cydx.ScopeOptional},
+			want: cydx.Component{Scope: cydx.ScopeOptional},
+		},
+		{
+			name: "less required scope is ignored",
+			kept: cydx.Component{Scope: cydx.ScopeRequired},
+			dup:  cydx.Component{Scope: cydx.ScopeExcluded},
+			want: cydx.Component{Scope: cydx.ScopeRequired},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kept := tt.kept
+			unionComp(&kept, &tt.dup)
+			if !reflect.DeepEqual(kept, tt.want) {
+				t.Errorf("unionComp() = %+v, want %+v", kept, tt.want)
+			}
+		})
+	}
+}
@@ -20,16 +20,28 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"time"
 
 	cydx "github.com/CycloneDX/cyclonedx-go"
-	"github.com/google/uuid"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/filter"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/report"
+	"github.com/interlynk-io/sbomasm/pkg/convert"
 	"github.com/interlynk-io/sbomasm/pkg/detect"
+	liclib "github.com/interlynk-io/sbomasm/pkg/licenses"
 	"github.com/interlynk-io/sbomasm/pkg/logger"
+	"github.com/interlynk-io/sbomasm/pkg/progress"
 	"github.com/samber/lo"
-	"sigs.k8s.io/release-utils/version"
+	spdx_json "github.com/spdx/tools-golang/json"
+	spdx_rdf "github.com/spdx/tools-golang/rdf"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+	spdx_tv "github.com/spdx/tools-golang/tagvalue"
+	spdx_yaml "github.com/spdx/tools-golang/yaml"
+	"go.uber.org/zap"
 )
 
 var specVersionMap = map[string]cydx.SpecVersion{
@@ -43,18 +55,24 @@ func validSpecVersion(specVersion string) bool {
 	return ok
 }
 
-func newSerialNumber() string {
-	u := uuid.New().String()
+func newSerialNumber(seed string) string {
+	u := stableUUID("serial:" + seed)
 
 	return fmt.Sprintf("urn:uuid:%s", u)
 }
 
-func newBomRef() string {
-	u := uuid.New().String()
+func newBomRef(seed string) string {
+	u := stableUUID(seed)
 
 	return fmt.Sprintf("lynk:%s", u)
 }
 
+// primaryBomRef derives the bom-ref of the assembled primary component from
+// its name and version, so that repeated assemblies use the same ref.
+func primaryBomRef(name, version string) string {
+	return newBomRef(fmt.Sprintf("%s@%s", name, version))
+}
+
 func cloneComp(c *cydx.Component) (*cydx.Component, error) {
 	var newComp cydx.Component
 
@@ -140,7 +158,7 @@ func isEmptyValue(v interface{}) bool {
 	return false
 }
 
-func loadBom(ctx context.Context, path string) (*cydx.BOM, error) {
+func loadBom(ctx context.Context, path string, rep *report.Report) (*cydx.BOM, error) {
 	log := logger.FromContext(ctx)
 
 	var err error
@@ -159,6 +177,21 @@ func loadBom(ctx context.Context, path string) (*cydx.BOM, error) {
 
 	log.Debugf("loading bom:%s spec:%s format:%s", path, spec, format)
 
+	if spec == detect.SBOMSpecSPDX {
+		doc, err := readSpdx(f, format)
+		if err != nil {
+			return nil, err
+		}
+		log.Debugf("converting spdx bom:%s to cyclonedx", path)
+		bom, dropped, err := convert.SpdxToCdx(doc)
+		if err != nil {
+			return nil, err
+		}
+		rep.AddDroppedFields(path, dropped, "no cyclonedx equivalent")
+		rep.AddInput(inputReport(path, string(spec), string(format), true, bom))
+		return bom, nil
+	}
+
 	switch format {
 	case detect.FileFormatJSON:
 		bom = new(cydx.BOM)
@@ -176,16 +209,64 @@ func loadBom(ctx context.Context, path string) (*cydx.BOM, error) {
 		panic("unsupported file format") // TODO: return error instead of panic
 	}
 
+	rep.AddInput(inputReport(path, string(spec), string(format), false, bom))
+
 	return bom, nil
 }
 
+func inputReport(path, spec, format string, converted bool, bom *cydx.BOM) report.Input {
+	in := report.Input{
+		File:         path,
+		Spec:         spec,
+		Format:       format,
+		Converted:    converted,
+		Components:   countComponents(bom.Components),
+		Dependencies: countDependencies(bom.Dependencies),
+	}
+	if bom.Metadata != nil && bom.Metadata.Component != nil {
+		in.Components += 1 + countComponents(bom.Metadata.Component.Components)
+	}
+	return in
+}
+
+func readSpdx(f io.Reader, format detect.FileFormat) (*v2_3.Document, error) {
+	switch format {
+	case detect.FileFormatJSON:
+		return spdx_json.Read(f)
+	case detect.FileFormatTagValue:
+		return spdx_tv.Read(f)
+	case detect.FileFormatYAML:
+		return spdx_yaml.Read(f)
+	case detect.FileFormatRDF:
+		return spdx_rdf.Read(f)
+	}
+	return nil, fmt.Errorf("unsupported spdx format %s", format)
+}
+
 func utcNowTime() string {
 	location, _ := time.LoadLocation("UTC")
 	locationTime := time.Now().In(location)
 	return locationTime.Format(time.RFC3339)
 }
 
-func buildToolList(in []*cydx.BOM) *cydx.ToolsChoice {
+// buildProperties records the commit and build date of sbomasm, so an sbom
+// can be traced back to the build which assembled it.
+func buildProperties(b build) *[]cydx.Property {
+	props := []cydx.Property{}
+	if b.GitCommit != "" {
+		props = append(props, cydx.Property{Name: "sbomasm:git_commit", Value: b.GitCommit})
+	}
+	if b.BuildDate != "" {
+		props = append(props, cydx.Property{Name: "sbomasm:build_date", Value: b.BuildDate})
+	}
+
+	if len(props) == 0 {
+		return nil
+	}
+	return &props
+}
+
+func buildToolList(in []*cydx.BOM, b build) *cydx.ToolsChoice {
 	tools := cydx.ToolsChoice{}
 
 	tools.Services = &[]cydx.Service{}
@@ -194,7 +275,7 @@ func buildToolList(in []*cydx.BOM) *cydx.ToolsChoice {
 	*tools.Components = append(*tools.Components, cydx.Component{
 		Type:        cydx.ComponentTypeApplication,
 		Name:        "sbomasm",
-		Version:     version.GetVersionInfo().GitVersion,
+		Version:     b.Version,
 		Description: "Assembler & Editor for your sboms",
 		Supplier: &cydx.OrganizationalEntity{
 			Name:    "Interlynk",
@@ -208,11 +289,12 @@ func buildToolList(in []*cydx.BOM) *cydx.ToolsChoice {
 				},
 			},
 		},
+		Properties: buildProperties(b),
 	})
 
 	for _, bom := range in {
 		if bom.Metadata != nil && bom.Metadata.Tools != nil {
-			for _, tool := range *bom.Metadata.Tools.Tools {
+			for _, tool := range lo.FromPtr(bom.Metadata.Tools.Tools) {
 				*tools.Components = append(*tools.Components, cydx.Component{
 					Type:    cydx.ComponentTypeApplication,
 					Name:    tool.Name,
@@ -253,51 +335,448 @@ func buildToolList(in []*cydx.BOM) *cydx.ToolsChoice {
 	return &tools
 }
 
-func buildComponentList(in []*cydx.BOM, cs *uniqueComponentService) []cydx.Component {
-	finalList := []cydx.Component{}
+// buildComponentList returns the unique components of all sboms. Pointers are
+// returned as duplicates found later are merged into the kept component. With
+// flatten, nested components are moved to the top level list, otherwise they
+// are stored as a tree, see StoreTreeAndCloneWithNewID.
+func buildComponentList(in []*cydx.BOM, files []string, cs *uniqueComponentService, flatten bool) []*cydx.Component {
+	finalList := []*cydx.Component{}
+
+	var store func(comps *[]cydx.Component)
+	store = func(comps *[]cydx.Component) {
+		for _, comp := range lo.FromPtr(comps) {
+			if !flatten {
+				newComp, duplicate := cs.StoreTreeAndCloneWithNewID(&comp)
+				if !duplicate {
+					finalList = append(finalList, newComp)
+				}
+				continue
+			}
 
-	for _, bom := range in {
-		for _, comp := range lo.FromPtr(bom.Components) {
 			newComp, duplicate := cs.StoreAndCloneWithNewID(&comp)
 			if !duplicate {
-				finalList = append(finalList, *newComp)
+				newComp.Components = nil
+				finalList = append(finalList, newComp)
 			}
+			store(comp.Components)
 		}
 	}
+
+	p := progress.FromContext(cs.ctx)
+	p.Start("merging sboms", len(in))
+	defer p.Done()
+
+	for i, bom := range in {
+		cs.setInput(files[i])
+		store(bom.Components)
+		p.Step(filepath.Base(files[i]))
+	}
 	return finalList
 }
 
-func buildPrimaryComponentList(in []*cydx.BOM, cs *uniqueComponentService) []cydx.Component {
-	return lo.Map(in, func(bom *cydx.BOM, _ int) cydx.Component {
+// sbomExtensions are stripped from the file name of an input to name its
+// synthesized primary component.
+var sbomExtensions = []string{".gz", ".json", ".xml", ".pb", ".cdx", ".bom", ".spdx"}
+
+// synthesizedRefPrefix starts the bom-ref of a synthesized primary component.
+const synthesizedRefPrefix = "sbomasm:primary:"
+
+// ensurePrimaryComp makes sure bom, the input at index i, describes a primary
+// component. One named after the file of the input, name as given by the user,
+// is added when it has none. When the primary component has no dependencies
+// it is linked to the components of the input which nothing else depends on,
+// so that all of them remain its transitive dependencies under the new root.
+func ensurePrimaryComp(bom *cydx.BOM, i int, name string, log *zap.SugaredLogger) {
+	if bom.Metadata == nil {
+		bom.Metadata = &cydx.Metadata{}
+	}
+
+	if bom.Metadata.Component == nil {
+		path := name
+		// members of zip archives are named archive!member
+		name = filepath.Base(name[strings.LastIndex(name, "!")+1:])
+		for trimmed := true; trimmed; {
+			trimmed = false
+			for _, ext := range sbomExtensions {
+				if strings.HasSuffix(strings.ToLower(name), ext) && len(name) > len(ext) {
+					name = name[:len(name)-len(ext)]
+					trimmed = true
+				}
+			}
+		}
+
+		log.Warnf("sbom %s has no primary component, using %s", path, name)
+		bom.Metadata.Component = &cydx.Component{
+			BOMRef: fmt.Sprintf("%s%d:%s", synthesizedRefPrefix, i, name),
+			Type:   cydx.ComponentTypeApplication,
+			Name:   name,
+		}
+	}
+
+	pc := bom.Metadata.Component
+	deps := lo.FromPtr(bom.Dependencies)
+	for _, d := range deps {
+		if d.Ref == pc.BOMRef && len(lo.FromPtr(d.Dependencies)) > 0 {
+			return
+		}
+	}
+
+	dependedOn := map[string]bool{}
+	for _, d := range deps {
+		for _, ref := range lo.FromPtr(d.Dependencies) {
+			dependedOn[ref] = true
+		}
+	}
+
+	comps := lo.FromPtr(bom.Components)
+	refs := lo.FilterMap(comps, func(c cydx.Component, _ int) (string, bool) {
+		return c.BOMRef, c.BOMRef != "" && c.BOMRef != pc.BOMRef && !dependedOn[c.BOMRef]
+	})
+	// every component is depended on by another one, e.g a cycle
+	if len(refs) == 0 {
+		refs = lo.FilterMap(comps, func(c cydx.Component, _ int) (string, bool) {
+			return c.BOMRef, c.BOMRef != "" && c.BOMRef != pc.BOMRef
+		})
+	}
+	if len(refs) == 0 {
+		return
+	}
+
+	deps = lo.Filter(deps, func(d cydx.Dependency, _ int) bool {
+		return d.Ref != pc.BOMRef
+	})
+	deps = append(deps, cydx.Dependency{Ref: pc.BOMRef, Dependencies: &refs})
+	bom.Dependencies = &deps
+}
+
+// buildPrimaryComponentList returns the unique primary components of all sboms,
+// a primary component which duplicates an earlier one is returned only once.
+// The nested components of a primary component, the assemblies of an sbom
+// which was itself assembled, are stored as a tree. A primary component which
+// is already nested in such a tree is left out, so it is listed once.
+func buildPrimaryComponentList(in []*cydx.BOM, files []string, cs *uniqueComponentService) []*cydx.Component {
+	priComps := []*cydx.Component{}
+	for i, bom := range in {
+		cs.setInput(files[i])
 		if bom.Metadata != nil && bom.Metadata.Component != nil {
-			newComp, duplicate := cs.StoreAndCloneWithNewID(bom.Metadata.Component)
-			if !duplicate {
-				return *newComp
+			newComp, _ := cs.StoreTreeAndCloneWithNewID(bom.Metadata.Component)
+			priComps = append(priComps, newComp)
+		}
+	}
+	return lo.Filter(lo.Uniq(priComps), func(c *cydx.Component, _ int) bool {
+		return !cs.IsNested(c)
+	})
+}
+
+// derefComponents copies the stored components along with their nested
+// components.
+func derefComponents(comps []*cydx.Component, cs *uniqueComponentService) []cydx.Component {
+	return lo.Map(comps, func(c *cydx.Component, _ int) cydx.Component {
+		return cs.Tree(c)
+	})
+}
+
+// filterComponents drops the components which the filter does not keep along
+// with their nested components, and records the refs of all dropped ones.
+func filterComponents(comps []cydx.Component, f filter.Filter, dropped map[string]bool) []cydx.Component {
+	var drop func(c cydx.Component)
+	drop = func(c cydx.Component) {
+		dropped[c.BOMRef] = true
+		for _, nc := range lo.FromPtr(c.Components) {
+			drop(nc)
+		}
+	}
+
+	kept := []cydx.Component{}
+	for _, c := range comps {
+		if !f.Keep(string(c.Scope), string(c.Type)) {
+			drop(c)
+			continue
+		}
+		if c.Components != nil {
+			nested := filterComponents(*c.Components, f, dropped)
+			c.Components = &nested
+		}
+		kept = append(kept, c)
+	}
+	return kept
+}
+
+// pruneDependencies removes the dependencies of and on dropped components,
+// entries left without any dependency are removed as well.
+func pruneDependencies(deps []cydx.Dependency, dropped map[string]bool) []cydx.Dependency {
+	return lo.FilterMap(deps, func(d cydx.Dependency, _ int) (cydx.Dependency, bool) {
+		if dropped[d.Ref] {
+			return d, false
+		}
+		refs := lo.Filter(lo.FromPtr(d.Dependencies), func(ref string, _ int) bool {
+			return !dropped[ref]
+		})
+		d.Dependencies = &refs
+		return d, len(refs) > 0
+	})
+}
+
+// scopeRank orders scopes from excluded to required, a component without a
+// scope is required.
+func scopeRank(scope cydx.Scope) int {
+	switch scope {
+	case cydx.ScopeExcluded:
+		return 0
+	case cydx.ScopeOptional:
+		return 1
+	}
+	return 2
+}
+
+// nestAssemblies nests the components of each sbom under its primary
+// component, after the nested components the primary component already has.
+// A component found in several sboms is nested once, under the primary
+// component of the first sbom. The components of sboms without a primary
+// component are returned as the rest.
+func nestAssemblies(in []*cydx.BOM, files []string, cs *uniqueComponentService, priComps, comps []cydx.Component) ([]cydx.Component, []cydx.Component) {
+	priIdx := make(map[string]int, len(priComps))
+	for i, pc := range priComps {
+		priIdx[pc.BOMRef] = i
+	}
+
+	compIdx := make(map[string]int, len(comps))
+	for i, c := range comps {
+		compIdx[c.BOMRef] = i
+	}
+
+	nested := make(map[string]bool, len(comps))
+	for i, bom := range in {
+		cs.setInput(files[i])
+		if bom.Metadata == nil || bom.Metadata.Component == nil {
+			continue
+		}
+
+		ref, _ := cs.ResolveDepID(bom.Metadata.Component.BOMRef)
+		pi, ok := priIdx[ref]
+		if !ok {
+			continue
+		}
+
+		pc := &priComps[pi]
+		for _, c := range lo.FromPtr(bom.Components) {
+			ref, _ := cs.ResolveDepID(c.BOMRef)
+			ci, ok := compIdx[ref]
+			if !ok || nested[ref] {
+				continue
 			}
+			nested[ref] = true
+
+			if pc.Components == nil {
+				pc.Components = &[]cydx.Component{}
+			}
+			*pc.Components = append(*pc.Components, comps[ci])
 		}
-		return cydx.Component{}
+	}
+
+	rest := lo.Filter(comps, func(c cydx.Component, _ int) bool {
+		return !nested[c.BOMRef]
 	})
+	return priComps, rest
 }
 
-func buildDependencyList(in []*cydx.BOM, cs *uniqueComponentService) []cydx.Dependency {
-	return lo.Flatten(lo.Map(in, func(bom *cydx.BOM, _ int) []cydx.Dependency {
-		newDeps := []cydx.Dependency{}
+// buildDependencyList returns the dependencies of all sboms with their refs
+// resolved to the new component ids, along with the number of refs which could
+// not be resolved.
+//
+// Entries of components which were deduplicated are merged into a single entry,
+// edges which became self references are dropped.
+func buildDependencyList(in []*cydx.BOM, files []string, cs *uniqueComponentService) ([]cydx.Dependency, int) {
+	dm := newDependencyMerger()
+
+	for i, bom := range in {
+		cs.setInput(files[i])
 		for _, dep := range lo.FromPtr(bom.Dependencies) {
-			nd := cydx.Dependency{}
-			ref, found := cs.ResolveDepID(dep.Ref)
-			if !found {
-				continue
+			dm.add(dep, cs)
+		}
+	}
+
+	return dm.list(), dm.unresolved
+}
+
+// dependencyMerger merges the dependencies of several sboms by their resolved
+// ref, keeping the order refs were first seen in.
+type dependencyMerger struct {
+	refs       []string
+	depMap     map[string][]string
+	unresolved int
+}
+
+func newDependencyMerger() *dependencyMerger {
+	return &dependencyMerger{
+		refs:   []string{},
+		depMap: make(map[string][]string),
+	}
+}
+
+// add resolves the refs of dep in the current input of cs and merges it.
+func (dm *dependencyMerger) add(dep cydx.Dependency, cs *uniqueComponentService) {
+	ref, found := cs.ResolveDepID(dep.Ref)
+	if !found {
+		dm.unresolved++
+		return
+	}
+
+	oldDeps := lo.FromPtr(dep.Dependencies)
+	deps := cs.ResolveDepIDs(oldDeps)
+	dm.unresolved += len(oldDeps) - len(deps)
+
+	if _, ok := dm.depMap[ref]; !ok {
+		dm.refs = append(dm.refs, ref)
+	}
+	dm.depMap[ref] = lo.Uniq(append(dm.depMap[ref], lo.Without(deps, ref)...))
+}
+
+// list returns the merged dependencies, refs without any dependency are left out.
+func (dm *dependencyMerger) list() []cydx.Dependency {
+	return lo.FilterMap(dm.refs, func(ref string, _ int) (cydx.Dependency, bool) {
+		d := dm.depMap[ref]
+		if len(d) == 0 {
+			return cydx.Dependency{}, false
+		}
+		return cydx.Dependency{Ref: ref, Dependencies: &d}, true
+	})
+}
+
+// countComponents returns the number of components including nested ones.
+func countComponents(comps *[]cydx.Component) int {
+	count := 0
+	for _, c := range lo.FromPtr(comps) {
+		count += 1 + countComponents(c.Components)
+	}
+	return count
+}
+
+// countDependencies returns the number of dependency edges.
+func countDependencies(deps *[]cydx.Dependency) int {
+	count := 0
+	for _, d := range lo.FromPtr(deps) {
+		count += len(lo.FromPtr(d.Dependencies))
+	}
+	return count
+}
+
+// normalizeLicenses maps the license ids, names and expressions to spdx ids
+// where possible and drops the licenses which become duplicates. Licenses
+// which can not be mapped are kept as is and returned.
+func normalizeLicenses(licenses *cydx.Licenses) []string {
+	if licenses == nil {
+		return nil
+	}
+
+	unmapped := []string{}
+	seen := map[string]bool{}
+	normalized := cydx.Licenses{}
+
+	for _, l := range *licenses {
+		switch {
+		case l.Expression != "":
+			norm, ok := liclib.Normalize(l.Expression)
+			if !ok {
+				unmapped = append(unmapped, l.Expression)
+			}
+			l.Expression = norm
+		case l.License != nil && (l.License.ID != "" || l.License.Name != ""):
+			value := l.License.ID
+			if value == "" {
+				value = l.License.Name
 			}
 
-			if len(*dep.Dependencies) == 0 {
-				continue
+			norm, ok := liclib.Normalize(value)
+			if !ok {
+				unmapped = append(unmapped, value)
+				break
+			}
+			if norm == "NONE" || norm == "NOASSERTION" {
+				break
+			}
+
+			// a license id can not hold an expression or a trailing +
+			if strings.ContainsAny(norm, " +") {
+				l = cydx.LicenseChoice{Expression: norm}
+				break
+			}
+			license := *l.License
+			license.ID, license.Name = norm, ""
+			l.License = &license
+		}
+
+		key := lo.FirstOr(licenseStrings(&cydx.Licenses{l}), "")
+		if key != "" && seen[key] {
+			continue
+		}
+		seen[key] = true
+		normalized = append(normalized, l)
+	}
+
+	*licenses = combineLicenses(normalized)
+	return unmapped
+}
+
+// combineLicenses turns a list mixing licenses and expressions, which the
+// cyclonedx schema does not allow, into a single expression of all of them.
+// When a license has no id the expressions are turned into license names
+// instead.
+func combineLicenses(licenses cydx.Licenses) cydx.Licenses {
+	if len(licenses) < 2 || !lo.SomeBy(licenses, func(l cydx.LicenseChoice) bool { return l.Expression != "" }) {
+		return licenses
+	}
+
+	byID := lo.EveryBy(licenses, func(l cydx.LicenseChoice) bool {
+		return l.Expression != "" || (l.License != nil && l.License.ID != "")
+	})
+	if !byID {
+		return lo.Map(licenses, func(l cydx.LicenseChoice, _ int) cydx.LicenseChoice {
+			if l.Expression != "" {
+				return cydx.LicenseChoice{License: &cydx.License{Name: l.Expression}}
 			}
+			return l
+		})
+	}
+
+	terms := lo.Map(licenses, func(l cydx.LicenseChoice, _ int) string {
+		if l.Expression == "" {
+			return l.License.ID
+		}
+		if strings.Contains(l.Expression, " ") {
+			return "(" + l.Expression + ")"
+		}
+		return l.Expression
+	})
+	return cydx.Licenses{{Expression: strings.Join(lo.Uniq(terms), " AND ")}}
+}
+
+// checkLicenses fails a strict license assembly when licenses could not be
+// mapped to spdx ids.
+func checkLicenses(ms *MergeSettings, cs *uniqueComponentService) error {
+	unmapped := cs.UnmappedLicenses()
+	if len(unmapped) == 0 {
+		return nil
+	}
+
+	if !ms.Assemble.StrictLicenses {
+		logger.FromContext(*ms.Ctx).Debugf("%d licenses could not be mapped to spdx ids, they are kept as is", len(unmapped))
+		return nil
+	}
+	return fmt.Errorf("licenses could not be mapped to spdx ids: %s", strings.Join(unmapped, "; "))
+}
 
-			deps := cs.ResolveDepIDs(lo.FromPtr(dep.Dependencies))
-			nd.Ref = ref
-			nd.Dependencies = &deps
-			newDeps = append(newDeps, nd)
+// licenseStrings returns the license ids, names and expressions of licenses.
+func licenseStrings(licenses *cydx.Licenses) []string {
+	return lo.Uniq(lo.FilterMap(lo.FromPtr(licenses), func(l cydx.LicenseChoice, _ int) (string, bool) {
+		switch {
+		case l.Expression != "":
+			return l.Expression, true
+		case l.License != nil && l.License.ID != "":
+			return l.License.ID, true
+		case l.License != nil && l.License.Name != "":
+			return l.License.Name, true
 		}
-		return newDeps
+		return "", false
 	}))
 }
@@ -18,12 +18,13 @@ package assemble
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
 	"github.com/interlynk-io/sbomasm/pkg/assemble/cdx"
 	"github.com/interlynk-io/sbomasm/pkg/assemble/spdx"
 	"github.com/interlynk-io/sbomasm/pkg/logger"
-	"github.com/samber/lo"
+	"sigs.k8s.io/release-utils/version"
 )
 
 type combiner struct {
@@ -35,12 +36,14 @@ func newCombiner(c *config) *combiner {
 	return &combiner{c: c}
 }
 
-func (c *combiner) combine() error {
+func (c *combiner) combine(w io.Writer) error {
 	log := logger.FromContext(*c.c.ctx)
 
 	if strings.EqualFold(c.finalSpec, "cyclonedx") {
 		log.Debugf("combining %d CycloneDX sboms", len(c.c.input.files))
 		ms := toCDXMergerSettings(c.c)
+		ms.Output.Writer = w
+		ms.Report = c.c.report
 
 		err := cdx.Merge(ms)
 		if err != nil {
@@ -52,6 +55,8 @@ func (c *combiner) combine() error {
 		log.Debugf("combining %d SPDX sboms", len(c.c.input.files))
 
 		ms := toSpdxMergerSettings(c.c)
+		ms.Output.Writer = w
+		ms.Report = c.c.report
 
 		err := spdx.Merge(ms)
 		if err != nil {
@@ -63,22 +68,12 @@ func (c *combiner) combine() error {
 }
 
 func (c *combiner) canCombine() error {
-	specs := []string{}
-
-	for _, doc := range c.c.input.files {
-		spec, _, err := detectSbom(doc)
-		if err != nil {
-			return fmt.Errorf("unable to detect sbom format for %s: %v", doc, err)
-		}
-		specs = append(specs, spec)
-	}
-
-	// all input specs should be of the same type
-	if len(lo.Uniq(specs)) != 1 {
-		return fmt.Errorf("input sboms are not of the same type")
+	// inputs of a different spec are converted to the output spec while loading
+	if c.c.Output.Spec == "" {
+		return fmt.Errorf("output spec is not set")
 	}
 
-	c.finalSpec = specs[0]
+	c.finalSpec = c.c.Output.Spec
 
 	return nil
 }
@@ -91,14 +86,24 @@ func toCDXMergerSettings(c *config) *cdx.MergeSettings {
 	ms.Assemble.FlatMerge = c.Assemble.FlatMerge
 	ms.Assemble.HierarchicalMerge = c.Assemble.HierarchicalMerge
 	ms.Assemble.AssemblyMerge = c.Assemble.AssemblyMerge
+	ms.Assemble.DedupBy = c.Assemble.DedupBy
+	ms.Assemble.LowMemory = c.Assemble.LowMemory
+	ms.Assemble.StrictLicenses = c.Assemble.StrictLicenses
+	ms.Assemble.Filter = c.Assemble.Filter
+	ms.Assemble.MinComponents = c.Assemble.MinComponents
 	ms.Assemble.IncludeComponents = c.Assemble.IncludeComponents
 	ms.Assemble.IncludeDuplicateComponents = c.Assemble.includeDuplicateComponents
 	ms.Assemble.IncludeDependencyGraph = c.Assemble.IncludeDependencyGraph
 
 	ms.Input.Files = []string{}
 	ms.Input.Files = append(ms.Input.Files, c.input.files...)
+	for _, f := range c.input.files {
+		ms.Input.Names = append(ms.Input.Names, c.input.name(f))
+	}
 
-	ms.Output.File = c.Output.file
+	ms.Build.Version, ms.Build.GitCommit, ms.Build.BuildDate = sbomasmBuild()
+
+	ms.Output.File = c.Output.File
 	ms.Output.Upload = c.Output.Upload
 	ms.Output.UploadProjectID = c.Output.UploadProjectID
 	ms.Output.Url = c.Output.Url
@@ -106,6 +111,8 @@ func toCDXMergerSettings(c *config) *cdx.MergeSettings {
 	ms.Output.FileFormat = c.Output.FileFormat
 	ms.Output.Spec = c.Output.Spec
 	ms.Output.SpecVersion = c.Output.SpecVersion
+	ms.Output.Timestamp = c.Output.Timestamp
+	ms.Output.NoTimestamp = c.Output.NoTimestamp
 
 	ms.App.Name = c.App.Name
 	ms.App.Version = c.App.Version
@@ -149,6 +156,11 @@ func toSpdxMergerSettings(c *config) *spdx.MergeSettings {
 
 	ms.Assemble.FlatMerge = c.Assemble.FlatMerge
 	ms.Assemble.HierarchicalMerge = c.Assemble.HierarchicalMerge
+	ms.Assemble.AssemblyMerge = c.Assemble.AssemblyMerge
+	ms.Assemble.DedupBy = c.Assemble.DedupBy
+	ms.Assemble.StrictLicenses = c.Assemble.StrictLicenses
+	ms.Assemble.Filter = c.Assemble.Filter
+	ms.Assemble.MinComponents = c.Assemble.MinComponents
 	ms.Assemble.IncludeComponents = c.Assemble.IncludeComponents
 	ms.Assemble.IncludeDuplicateComponents = c.Assemble.includeDuplicateComponents
 	ms.Assemble.IncludeDependencyGraph = c.Assemble.IncludeDependencyGraph
@@ -156,8 +168,13 @@ func toSpdxMergerSettings(c *config) *spdx.MergeSettings {
 	ms.Input.Files = []string{}
 	ms.Input.Files = append(ms.Input.Files, c.input.files...)
 
-	ms.Output.File = c.Output.file
+	ms.Build.Version, ms.Build.GitCommit, ms.Build.BuildDate = sbomasmBuild()
+
+	ms.Output.File = c.Output.File
 	ms.Output.FileFormat = c.Output.FileFormat
+	ms.Output.Spec = c.Output.Spec
+	ms.Output.SpecVersion = c.Output.SpecVersion
+	ms.Output.Timestamp = c.Output.Timestamp
 
 	ms.App.Name = c.App.Name
 	ms.App.Version = c.App.Version
@@ -193,3 +210,20 @@ func toSpdxMergerSettings(c *config) *spdx.MergeSettings {
 
 	return &ms
 }
+
+// sbomasmBuild returns the version of sbomasm with the commit and build date
+// embedded at build time, which are recorded in the assembled sbom. Values
+// which were not embedded are empty.
+func sbomasmBuild() (string, string, string) {
+	info := version.GetVersionInfo()
+	return info.GitVersion, known(info.GitCommit), known(info.BuildDate)
+}
+
+// known returns value when it was embedded, release-utils defaults missing
+// ones to unknown.
+func known(value string) string {
+	if value == "unknown" {
+		return ""
+	}
+	return value
+}
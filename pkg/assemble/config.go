@@ -24,11 +24,21 @@ import (
 	"io"
 	"log"
 	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/interlynk-io/sbomasm/pkg/assemble/cdx"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/dedup"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/filter"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/report"
 	"github.com/interlynk-io/sbomasm/pkg/logger"
+	"github.com/interlynk-io/sbomasm/pkg/schema"
+	"github.com/interlynk-io/sbomasm/pkg/sign"
+	packageurl "github.com/package-url/packageurl-go"
 	"github.com/samber/lo"
 	"gopkg.in/yaml.v2"
 )
@@ -36,6 +46,7 @@ import (
 const (
 	DEFAULT_OUTPUT_SPEC         = "cyclonedx"
 	DEFAULT_OUTPUT_SPEC_VERSION = "1.6"
+	DEFAULT_SPDX_SPEC_VERSION   = "2.3"
 	DEFAULT_OUTPUT_FILE_FORMAT  = "json"
 	DEFAULT_OUTPUT_LICENSE      = "CC0-1.0"
 )
@@ -76,35 +87,118 @@ type app struct {
 }
 
 type output struct {
-	Spec            string `yaml:"spec"`
-	SpecVersion     string `yaml:"spec_version"`
+	Spec            string `yaml:"spec,omitempty"`
+	SpecVersion     string `yaml:"spec_version,omitempty"`
 	FileFormat      string `yaml:"file_format"`
-	file            string
-	Upload          bool
-	UploadProjectID uuid.UUID
-	Url             string
-	ApiKey          string
+	File            string `yaml:"file,omitempty"`
+	reportFile      string
+	Upload          bool      `yaml:"-"`
+	UploadProjectID uuid.UUID `yaml:"-"`
+	Url             string    `yaml:"-"`
+	ApiKey          string    `yaml:"-"`
+
+	// UploadKeys are the upload settings older config files carry, they are
+	// ignored as the upload is configured by the dt flags only
+	UploadKeys uploadKeys `yaml:",inline"`
+
+	// Timestamp is the creation time of the output in RFC3339, defaults to
+	// SOURCE_DATE_EPOCH when set, otherwise the current time. NoTimestamp
+	// leaves it out for reproducible CycloneDX output.
+	Timestamp   string `yaml:"timestamp,omitempty"`
+	NoTimestamp bool   `yaml:"no_timestamp,omitempty"`
+
+	// Validate checks the output against the schema of its spec version
+	// before it is written
+	Validate bool `yaml:"validate,omitempty"`
+
+	// targets are written from a single merge instead of File
+	targets []outputTarget
+
+	// signer signs each output file, the signature is written next to it
+	signer *sign.Signer
+}
+
+type uploadKeys struct {
+	Upload          interface{} `yaml:"upload,omitempty"`
+	UploadProjectID interface{} `yaml:"uploadprojectid,omitempty"`
+	Url             interface{} `yaml:"url,omitempty"`
+	ApiKey          interface{} `yaml:"apikey,omitempty"`
+}
+
+// set returns the keys which are set.
+func (u uploadKeys) set() []string {
+	keys := []string{}
+	if u.Upload != nil {
+		keys = append(keys, "upload")
+	}
+	if u.UploadProjectID != nil {
+		keys = append(keys, "uploadprojectid")
+	}
+	if u.Url != nil {
+		keys = append(keys, "url")
+	}
+	if u.ApiKey != nil {
+		keys = append(keys, "apikey")
+	}
+	return keys
+}
+
+// inputList holds the inputs listed in the config file
+type inputList struct {
+	Files []string `yaml:"files,omitempty"`
 }
 
 type input struct {
 	files []string
+	// archives holds the files extracted from compressed inputs
+	archives *archiveExpander
+	// specs and formats hold the detected spec and file format of each file
+	specs   []string
+	formats []string
+}
+
+// name returns the path of an input as given by the user, files extracted from
+// an archive are named after their location in it.
+func (in *input) name(path string) string {
+	if in.archives != nil {
+		if name, ok := in.archives.names[path]; ok {
+			return name
+		}
+	}
+	return path
 }
 
 type assemble struct {
 	IncludeDependencyGraph     bool `yaml:"include_dependency_graph"`
 	IncludeComponents          bool `yaml:"include_components"`
 	includeDuplicateComponents bool
-	FlatMerge                  bool `yaml:"flat_merge"`
-	HierarchicalMerge          bool `yaml:"hierarchical_merge"`
-	AssemblyMerge              bool `yaml:"assembly_merge"`
+	FlatMerge                  bool          `yaml:"flat_merge"`
+	HierarchicalMerge          bool          `yaml:"hierarchical_merge"`
+	AssemblyMerge              bool          `yaml:"assembly_merge"`
+	DedupBy                    string        `yaml:"dedup_by"`
+	LowMemory                  bool          `yaml:"low_memory"`
+	StrictLicenses             bool          `yaml:"strict_licenses,omitempty"`
+	Filter                     filter.Filter `yaml:"filter,omitempty"`
+
+	// MinComponents fails the assembly when the merged sbom, after
+	// deduplication and filtering, has fewer components
+	MinComponents int `yaml:"min_components,omitempty"`
 }
 
 type config struct {
 	ctx      *context.Context
-	App      app    `yaml:"app"`
-	Output   output `yaml:"output"`
+	App      app       `yaml:"app"`
+	Output   output    `yaml:"output"`
+	Input    inputList `yaml:"input,omitempty"`
 	input    input
 	Assemble assemble `yaml:"assemble"`
+
+	// report is filled by the merge when set
+	report *report.Report
+
+	// validated is set once validate succeeds, it detects and hashes every
+	// input so it only runs once
+	validated bool
 }
 
 var defaultConfig = config{
@@ -130,10 +224,14 @@ var defaultConfig = config{
 		},
 		Copyright: "[OPTIONAL]",
 	},
+	// the output spec and version are left out, they are derived from the
+	// inputs unless set
 	Output: output{
-		Spec:        DEFAULT_OUTPUT_SPEC,
-		SpecVersion: DEFAULT_OUTPUT_SPEC_VERSION,
-		FileFormat:  DEFAULT_OUTPUT_FILE_FORMAT,
+		FileFormat: DEFAULT_OUTPUT_FILE_FORMAT,
+		File:       "[OPTIONAL]",
+	},
+	Input: inputList{
+		Files: []string{"[OPTIONAL]"},
 	},
 	Assemble: assemble{
 		FlatMerge:                  false,
@@ -142,23 +240,26 @@ var defaultConfig = config{
 		IncludeComponents:          true,
 		IncludeDependencyGraph:     true,
 		includeDuplicateComponents: true,
+		DedupBy:                    dedup.Default,
 	},
 }
 
+// DefaultConfig prints a sample config file to stdout.
 func DefaultConfig() {
-	_, err := yaml.Marshal(&defaultConfig)
+	b, err := yaml.Marshal(&defaultConfig)
 	if err != nil {
 		log.Fatal(err)
 	}
+	fmt.Print(string(b))
 }
 
 // NewConfig: Creating a new configuration instance with default values.
+// The output spec and version are left empty, they are derived from the
+// input sboms unless explicitly set.
 func NewConfig() *config {
 	return &config{
 		Output: output{
-			Spec:        DEFAULT_OUTPUT_SPEC,
-			SpecVersion: DEFAULT_OUTPUT_SPEC_VERSION,
-			FileFormat:  DEFAULT_OUTPUT_FILE_FORMAT,
+			FileFormat: DEFAULT_OUTPUT_FILE_FORMAT,
 		},
 		Assemble: assemble{
 			FlatMerge:                  false,
@@ -166,6 +267,7 @@ func NewConfig() *config {
 			IncludeComponents:          true,
 			IncludeDependencyGraph:     true,
 			includeDuplicateComponents: true,
+			DedupBy:                    dedup.Default,
 		},
 	}
 }
@@ -177,44 +279,170 @@ func PopulateConfig(aParams *Params) (*config, error) {
 	}
 	config := NewConfig()
 	if err := config.readAndMerge(aParams); err != nil {
+		config.cleanup()
 		return nil, err
 	}
 	if err := config.validate(); err != nil {
+		config.cleanup()
 		return nil, err
 	}
 	return config, nil
 }
 
-// readAndMerge: Merging user-specified parameters into the configuration.
-func (c *config) readAndMerge(p *Params) error {
-	if p.ConfigPath != "" {
+// cleanup removes the files extracted from compressed inputs.
+func (c *config) cleanup() error {
+	if c == nil || c.input.archives == nil {
+		return nil
+	}
+	return c.input.archives.cleanup()
+}
 
-		yF, err := os.ReadFile(p.ConfigPath)
-		if err != nil {
-			return err
+// readConfigFile reads the yaml config file at path, unknown keys are an
+// error, the upload keys of older config files are ignored with a warning.
+// Relative input and output paths are resolved against the directory of the
+// config file.
+func (c *config) readConfigFile(path string) error {
+	yF, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	// the merge mode comes from the file, hierarchical when it sets none
+	c.Assemble.HierarchicalMerge = false
+
+	if err := yaml.UnmarshalStrict(yF, c); err != nil {
+		return fmt.Errorf("invalid config file %s: %v", path, err)
+	}
+
+	if keys := c.Output.UploadKeys.set(); len(keys) > 0 {
+		logger.FromContext(*c.ctx).Warnf("ignoring output %s of config file %s, the upload is configured with the dt command flags",
+			strings.Join(keys, ", "), path)
+		c.Output.UploadKeys = uploadKeys{}
+	}
+
+	if !c.Assemble.FlatMerge && !c.Assemble.AssemblyMerge {
+		c.Assemble.HierarchicalMerge = true
+	}
+
+	resolve := func(f string) string {
+		f = strings.TrimSpace(f)
+		if f == "" || strings.ToLower(f) == "[optional]" {
+			return ""
+		}
+		if filepath.IsAbs(f) {
+			return f
 		}
+		return filepath.Join(filepath.Dir(path), f)
+	}
 
-		err = yaml.Unmarshal(yF, &c)
-		if err != nil {
+	c.Input.Files = lo.Compact(lo.Map(c.Input.Files, func(f string, _ int) string {
+		return resolve(f)
+	}))
+	c.Output.File = resolve(c.Output.File)
+
+	return nil
+}
+
+// validateMergeMode checks that at most one merge mode is set, as the merge
+// mode flags are mutually exclusive.
+func (c *config) validateMergeMode() error {
+	modes := []string{}
+	if c.Assemble.FlatMerge {
+		modes = append(modes, "flat_merge")
+	}
+	if c.Assemble.HierarchicalMerge {
+		modes = append(modes, "hierarchical_merge")
+	}
+	if c.Assemble.AssemblyMerge {
+		modes = append(modes, "assembly_merge")
+	}
+
+	if len(modes) > 1 {
+		return fmt.Errorf("conflicting merge modes %s, only one of flat_merge, hierarchical_merge and assembly_merge can be set", strings.Join(modes, ", "))
+	}
+	return nil
+}
+
+// readAndMerge: Merging user-specified parameters into the configuration.
+func (c *config) readAndMerge(p *Params) error {
+	c.ctx = p.Ctx
+	if c.ctx == nil {
+		return errors.New("config context is not initialized")
+	}
+
+	if p.ConfigPath != "" {
+		if err := c.readConfigFile(p.ConfigPath); err != nil {
 			return err
 		}
-	} else {
+	}
 
+	// merge mode flags replace the merge mode of the config file
+	if p.FlatMerge || p.HierMerge || p.AssemblyMerge {
 		c.Assemble.FlatMerge = p.FlatMerge
 		c.Assemble.HierarchicalMerge = p.HierMerge
 		c.Assemble.AssemblyMerge = p.AssemblyMerge
 	}
 
-	c.input.files = p.Input
-	c.Output.file = p.Output
+	if err := c.validateMergeMode(); err != nil {
+		return err
+	}
+
+	inputs := p.Input
+	if len(inputs) == 0 {
+		inputs = c.Input.Files
+	}
+
+	maxInputSize := p.MaxInputSize
+	if maxInputSize <= 0 {
+		maxInputSize = DEFAULT_MAX_INPUT_SIZE
+	}
+	c.input.archives = &archiveExpander{maxSize: maxInputSize}
+
+	files, err := c.input.archives.expand(inputs)
+	if err != nil {
+		return err
+	}
+	c.input.files = files
+
+	if p.Output != "" {
+		c.Output.File = p.Output
+	}
+
+	if len(p.OutputFormats) > 0 {
+		if p.Output != "" {
+			return fmt.Errorf("--output-format can not be combined with --output")
+		}
+		if p.OutputSpec != "" || p.Xml || p.Proto || (p.SpdxFormat != "" && p.SpdxFormat != "json") {
+			return fmt.Errorf("--output-format sets the spec and format of each output, it can not be combined with -g, -s, -x, --proto or --spdx-format")
+		}
+		if p.LowMemory {
+			return fmt.Errorf("low memory assembly can not write several outputs")
+		}
+
+		targets, err := parseOutputTargets(p.OutputFormats)
+		if err != nil {
+			return err
+		}
+		for i := range targets {
+			if targets[i].Spec == "cyclonedx" {
+				targets[i].SpecVersion = strings.TrimSpace(p.OutputSpecVersion)
+			}
+		}
+		c.Output.targets = targets
+		c.Output.File = ""
+	}
+	c.Output.reportFile = p.Report
+	if p.SignKey != "" {
+		signer, err := sign.LoadSigner(p.SignKey)
+		if err != nil {
+			return err
+		}
+		c.Output.signer = signer
+	}
 	c.Output.Upload = p.Upload
 	c.Output.UploadProjectID = p.UploadProjectID
 	c.Output.Url = p.Url
 	c.Output.ApiKey = p.ApiKey
-	c.ctx = p.Ctx
-	if c.ctx == nil {
-		return errors.New("config context is not initialized")
-	}
 
 	// override default config with params
 	if p.Name != "" {
@@ -229,18 +457,106 @@ func (c *config) readAndMerge(p *Params) error {
 		c.App.PrimaryPurpose = strings.Trim(p.Type, " ")
 	}
 
+	if p.Purl != "" {
+		c.App.Purl = strings.Trim(p.Purl, " ")
+	}
+
+	if p.CPE != "" {
+		c.App.CPE = strings.Trim(p.CPE, " ")
+	}
+
 	if p.Xml {
 		c.Output.FileFormat = "xml"
 	}
 
+	if p.Proto {
+		if p.Xml {
+			return fmt.Errorf("xml output can not be combined with protobuf output")
+		}
+		c.Output.FileFormat = "proto"
+	}
+
+	if p.SpdxFormat != "" {
+		format := strings.ToLower(strings.Trim(p.SpdxFormat, " "))
+		if p.Xml && format == "tagvalue" {
+			return fmt.Errorf("xml output can not be combined with spdx tag-value output")
+		}
+		if p.Proto && format == "tagvalue" {
+			return fmt.Errorf("protobuf output can not be combined with spdx tag-value output")
+		}
+		if format != "json" {
+			c.Output.FileFormat = format
+		}
+	}
+
 	if p.OutputSpec != "" {
 		c.Output.Spec = strings.Trim(p.OutputSpec, " ")
 	}
 
-	if p.OutputSpecVersion != "" {
+	if p.OutputSpecVersion != "" && len(p.OutputFormats) == 0 {
 		c.Output.SpecVersion = strings.Trim(p.OutputSpecVersion, " ")
 	}
 
+	if p.DedupBy != "" {
+		c.Assemble.DedupBy = strings.Trim(p.DedupBy, " ")
+	}
+
+	if p.LowMemory {
+		c.Assemble.LowMemory = true
+	}
+
+	if p.StrictLicenses {
+		c.Assemble.StrictLicenses = true
+	}
+
+	if len(p.IncludeScopes) > 0 {
+		c.Assemble.Filter.IncludeScopes = p.IncludeScopes
+	}
+
+	if len(p.ExcludeScopes) > 0 {
+		c.Assemble.Filter.ExcludeScopes = p.ExcludeScopes
+	}
+
+	if len(p.IncludeTypes) > 0 {
+		c.Assemble.Filter.IncludeTypes = p.IncludeTypes
+	}
+
+	if len(p.ExcludeTypes) > 0 {
+		c.Assemble.Filter.ExcludeTypes = p.ExcludeTypes
+	}
+
+	if p.MinComponents != 0 {
+		c.Assemble.MinComponents = p.MinComponents
+	}
+
+	if p.FailOnEmpty && c.Assemble.MinComponents < 1 {
+		c.Assemble.MinComponents = 1
+	}
+
+	if len(p.Authors) > 0 {
+		c.App.Author = lo.Map(p.Authors, func(a string, _ int) author {
+			name, email := parseContact(a)
+			return author{Name: name, Email: email}
+		})
+	}
+
+	if p.Supplier != "" {
+		name, email := parseContact(p.Supplier)
+		c.App.Supplier = supplier{Name: name, Email: email}
+	}
+
+	if p.Timestamp != "" {
+		c.Output.Timestamp = p.Timestamp
+	}
+
+	if p.NoTimestamp {
+		c.Output.NoTimestamp = true
+	}
+
+	if p.Validate {
+		c.Output.Validate = true
+	}
+
 	return nil
 }
 
@@ -288,6 +604,17 @@ func (c *config) validate() error {
 	c.App.Supplier.Email = sanitize(c.App.Supplier.Email)
 	c.App.Purl = sanitize(c.App.Purl)
 	c.App.CPE = sanitize(c.App.CPE)
+
+	if c.App.Purl != "" {
+		if _, err := packageurl.FromString(c.App.Purl); err != nil {
+			return fmt.Errorf("invalid purl %s: %v", c.App.Purl, err)
+		}
+	}
+
+	if c.App.CPE != "" && !strings.HasPrefix(c.App.CPE, "cpe:2.3:") && !strings.HasPrefix(c.App.CPE, "cpe:/") {
+		return fmt.Errorf("invalid cpe %s, expected a cpe 2.3 formatted string or a cpe uri", c.App.CPE)
+	}
+
 	c.App.Copyright = sanitize(c.App.Copyright)
 	c.Output.Spec = sanitize(c.Output.Spec)
 	c.Output.SpecVersion = sanitize(c.Output.SpecVersion)
@@ -297,6 +624,10 @@ func (c *config) validate() error {
 		c.App.Author[i].Name = sanitize(c.App.Author[i].Name)
 		c.App.Author[i].Email = sanitize(c.App.Author[i].Email)
 	}
+	// the placeholder author of a generated config file
+	c.App.Author = lo.Filter(c.App.Author, func(a author, _ int) bool {
+		return a.Name != "" || a.Email != ""
+	})
 
 	for i := range c.App.Checksums {
 		sAlgo := sanitize(c.App.Checksums[i].Algorithm)
@@ -326,6 +657,23 @@ func (c *config) validate() error {
 		c.Output.FileFormat = DEFAULT_OUTPUT_FILE_FORMAT
 	}
 
+	c.Assemble.DedupBy = strings.ToLower(sanitize(c.Assemble.DedupBy))
+	if c.Assemble.DedupBy == "" {
+		c.Assemble.DedupBy = dedup.Default
+	}
+
+	if !dedup.Valid(c.Assemble.DedupBy) {
+		return fmt.Errorf("unsupported dedup strategy %s :: use one of these %+v", c.Assemble.DedupBy, dedup.Strategies)
+	}
+
+	if err := c.Assemble.Filter.Normalize(); err != nil {
+		return err
+	}
+
+	if c.Assemble.MinComponents < 0 {
+		return fmt.Errorf("minimum number of components can not be negative")
+	}
+
 	if c.input.files == nil || len(c.input.files) == 0 {
 		return fmt.Errorf("input files are not set")
 	}
@@ -339,11 +687,56 @@ func (c *config) validate() error {
 		return err
 	}
 
-	log.Debugf("config %+v", c)
+	err = c.validateInputSpecs()
+	if err != nil {
+		return err
+	}
+
+	err = c.validateFileFormat()
+	if err != nil {
+		return err
+	}
+
+	err = c.validateLowMemory()
+	if err != nil {
+		return err
+	}
+
+	err = c.validateTimestamp()
+	if err != nil {
+		return err
+	}
+
+	err = c.validateSchemaCheck()
+	if err != nil {
+		return err
+	}
+
+	err = c.validateTargets()
+	if err != nil {
+		return err
+	}
+
+	err = c.validateSigning()
+	if err != nil {
+		return err
+	}
+
+	log.Debugf("config %+v", c.redacted())
 
+	c.validated = true
 	return nil
 }
 
+// redacted returns a copy of the config without the api key, to be logged.
+func (c *config) redacted() config {
+	r := *c
+	if r.Output.ApiKey != "" {
+		r.Output.ApiKey = "[REDACTED]"
+	}
+	return r
+}
+
 func (c *config) validateInputContent() error {
 	log := logger.FromContext(*c.ctx)
 	sha256 := func(path string) string {
@@ -370,8 +763,208 @@ func (c *config) validateInputContent() error {
 	uniqSums := lo.Uniq(sums)
 
 	if len(sums) != len(uniqSums) {
-		return fmt.Errorf("input sboms contain duplicate content %+v", c.input.files)
+		return fmt.Errorf("input sboms contain duplicate content %+v", lo.Map(c.input.files, func(f string, _ int) string {
+			return c.input.name(f)
+		}))
+	}
+
+	return nil
+}
+
+// validateInputSpecs detects the spec and file format of every input sbom from
+// its content and resolves the output spec. If no output spec is set it defaults
+// to the spec of the inputs, inputs of different specs need an explicit output
+// spec and are converted to it.
+func (c *config) validateInputSpecs() error {
+	log := logger.FromContext(*c.ctx)
+
+	c.input.specs = []string{}
+	c.input.formats = []string{}
+	filesBySpec := map[string][]string{}
+
+	for _, f := range c.input.files {
+		spec, format, err := detectSbom(f)
+		if err != nil {
+			return fmt.Errorf("unable to detect sbom format for %s: %v", c.input.name(f), err)
+		}
+		log.Debugf("detected %s spec:%s format:%s", f, spec, format)
+
+		c.input.specs = append(c.input.specs, spec)
+		c.input.formats = append(c.input.formats, format)
+		filesBySpec[spec] = append(filesBySpec[spec], c.input.name(f))
+	}
+
+	// the inputs are merged in a single spec, the other is converted from it
+	if len(c.Output.targets) > 0 {
+		t := c.mergeSpec(lo.Keys(filesBySpec))
+		c.Output.Spec, c.Output.SpecVersion, c.Output.FileFormat = t.Spec, t.SpecVersion, "json"
+	}
+
+	if c.Output.Spec == "" {
+		if len(filesBySpec) > 1 {
+			specs := lo.Keys(filesBySpec)
+			sort.Strings(specs)
+
+			details := lo.Map(specs, func(spec string, _ int) string {
+				return fmt.Sprintf("%s: %s", spec, strings.Join(filesBySpec[spec], ", "))
+			})
+			return fmt.Errorf("input sboms are not of the same spec, %s; set the output spec with -g or -s to convert them", strings.Join(details, "; "))
+		}
+		c.Output.Spec = c.input.specs[0]
 	}
 
+	c.Output.Spec = strings.ToLower(c.Output.Spec)
+	if c.Output.Spec != "cyclonedx" && c.Output.Spec != "spdx" {
+		return fmt.Errorf("unsupported output spec %s, expected cyclonedx or spdx", c.Output.Spec)
+	}
+
+	for spec, files := range filesBySpec {
+		if spec != c.Output.Spec {
+			log.Debugf("converting %s inputs to %s: %s", spec, c.Output.Spec, strings.Join(files, ", "))
+		}
+	}
+
+	if c.Output.SpecVersion == "" {
+		if c.Output.Spec == "spdx" {
+			c.Output.SpecVersion = DEFAULT_SPDX_SPEC_VERSION
+		} else {
+			c.Output.SpecVersion = DEFAULT_OUTPUT_SPEC_VERSION
+		}
+	}
+
+	return nil
+}
+
+// validateFileFormat checks that the output file format is supported by the
+// output spec, tag-value is only defined for spdx and protobuf for cyclonedx.
+func (c *config) validateFileFormat() error {
+	c.Output.FileFormat = strings.ToLower(c.Output.FileFormat)
+
+	switch c.Output.FileFormat {
+	case "json", "xml":
+		return nil
+	case "tagvalue":
+		if c.Output.Spec != "spdx" {
+			return fmt.Errorf("tag-value output requires the spdx output spec, set it with -s")
+		}
+		return nil
+	case "proto":
+		if c.Output.Spec != "cyclonedx" {
+			return fmt.Errorf("protobuf output requires the cyclonedx output spec")
+		}
+		if !cdx.SupportsProto(c.Output.SpecVersion) {
+			return fmt.Errorf("protobuf is not defined for cyclonedx %s", c.Output.SpecVersion)
+		}
+		if c.Output.Upload {
+			return fmt.Errorf("protobuf output can not be uploaded to dependency track")
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported output file format %s, expected json, xml, proto or tagvalue", c.Output.FileFormat)
+}
+
+// validateSchemaCheck checks that a schema is bundled for the output when it
+// is validated.
+func (c *config) validateSchemaCheck() error {
+	if !c.Output.Validate {
+		return nil
+	}
+
+	if c.Output.FileFormat != "json" {
+		return fmt.Errorf("schema validation requires json output")
+	}
+
+	if c.Output.Upload {
+		return fmt.Errorf("schema validation is not supported when uploading")
+	}
+
+	if !schema.Supported(c.Output.Spec, c.Output.SpecVersion) {
+		return fmt.Errorf("no schema is bundled for %s %s", c.Output.Spec, c.Output.SpecVersion)
+	}
+	return nil
+}
+
+// validateLowMemory checks that a low memory assembly, which streams the
+// components of the inputs to the output, is a flat merge of CycloneDX json
+// sboms into a CycloneDX json sbom.
+func (c *config) validateLowMemory() error {
+	if !c.Assemble.LowMemory {
+		return nil
+	}
+
+	if !c.Assemble.FlatMerge {
+		return fmt.Errorf("low memory assembly requires flat merge")
+	}
+
+	if !c.Assemble.Filter.Empty() {
+		return fmt.Errorf("low memory assembly can not filter components by scope or type")
+	}
+
+	if c.Output.Spec != "cyclonedx" || c.Output.FileFormat != "json" {
+		return fmt.Errorf("low memory assembly requires cyclonedx json output")
+	}
+
+	for i, f := range c.input.files {
+		if c.input.specs[i] != "cyclonedx" || c.input.formats[i] != "json" {
+			return fmt.Errorf("low memory assembly requires cyclonedx json inputs, %s is %s %s", c.input.name(f), c.input.specs[i], c.input.formats[i])
+		}
+	}
+
+	return nil
+}
+
+// validateTimestamp normalizes the output timestamp to UTC. When neither a
+// timestamp nor NoTimestamp is set, SOURCE_DATE_EPOCH is used if present.
+func (c *config) validateTimestamp() error {
+	c.Output.Timestamp = strings.TrimSpace(c.Output.Timestamp)
+
+	if c.Output.NoTimestamp {
+		if c.Output.Timestamp != "" {
+			return fmt.Errorf("timestamp %s can not be set along with no timestamp", c.Output.Timestamp)
+		}
+		if c.Output.Spec == "spdx" {
+			return fmt.Errorf("spdx requires a created timestamp, set a fixed one instead of leaving it out")
+		}
+		return nil
+	}
+
+	if c.Output.Timestamp == "" {
+		epoch := os.Getenv("SOURCE_DATE_EPOCH")
+		if epoch == "" {
+			return nil
+		}
+
+		secs, err := strconv.ParseInt(epoch, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid SOURCE_DATE_EPOCH %s: %v", epoch, err)
+		}
+		c.Output.Timestamp = time.Unix(secs, 0).UTC().Format(time.RFC3339)
+		return nil
+	}
+
+	t, err := time.Parse(time.RFC3339, c.Output.Timestamp)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp %s, expected RFC3339 e.g 2023-05-04T10:00:00Z", c.Output.Timestamp)
+	}
+	c.Output.Timestamp = t.UTC().Format(time.RFC3339)
+
 	return nil
 }
+
+// parseContact splits "name <email>" or "name (email)" into the name and email,
+// either part may be missing.
+func parseContact(s string) (string, string) {
+	s = strings.TrimSpace(s)
+
+	for _, delims := range []string{"<>", "()"} {
+		open := strings.LastIndex(s, delims[:1])
+		if open != -1 && strings.HasSuffix(s, delims[1:]) {
+			return strings.TrimSpace(s[:open]), strings.TrimSpace(s[open+1 : len(s)-1])
+		}
+	}
+
+	if strings.Contains(s, "@") && !strings.Contains(s, " ") {
+		return "", s
+	}
+	return s, ""
+}
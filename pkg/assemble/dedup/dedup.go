@@ -0,0 +1,80 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dedup decides when two components of the input sboms are the same.
+package dedup
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	Purl        = "purl"
+	NameVersion = "name-version"
+	CPE         = "cpe"
+	None        = "none"
+
+	Default = Purl
+)
+
+var Strategies = []string{Purl, NameVersion, CPE, None}
+
+func Valid(strategy string) bool {
+	for _, s := range Strategies {
+		if s == strategy {
+			return true
+		}
+	}
+	return false
+}
+
+// Identity holds the fields used to identify a component.
+type Identity struct {
+	Purl    string
+	CPE     string
+	Type    string
+	Group   string
+	Name    string
+	Version string
+}
+
+// Key returns the lookup key of a component for the strategy along with the
+// key type actually used. The purl and cpe strategies fall back to name and
+// version when the component has no purl or cpe, components of different
+// types are never the same by name and version. An empty key means the
+// component is never a duplicate.
+func Key(strategy string, id Identity) (string, string) {
+	switch strategy {
+	case None:
+		return "", None
+	case Purl:
+		if p := strings.TrimSpace(id.Purl); p != "" {
+			return fmt.Sprintf("%s:%s", Purl, p), Purl
+		}
+	case CPE:
+		if c := strings.TrimSpace(id.CPE); c != "" {
+			return fmt.Sprintf("%s:%s", CPE, strings.ToLower(c)), CPE
+		}
+	}
+
+	name := strings.ToLower(strings.TrimSpace(id.Name))
+	if g := strings.ToLower(strings.TrimSpace(id.Group)); g != "" {
+		name = g + "/" + name
+	}
+	typ := strings.ToLower(strings.TrimSpace(id.Type))
+	return fmt.Sprintf("%s:%s:%s@%s", NameVersion, typ, name, strings.ToLower(strings.TrimSpace(id.Version))), NameVersion
+}
@@ -0,0 +1,115 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package filter decides which components of the assembled sbom are kept, by
+// their scope and type. Both are compared in lower case, so the cyclonedx
+// component types match the spdx primary package purposes of the same name.
+package filter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+const (
+	Required = "required"
+	Optional = "optional"
+	Excluded = "excluded"
+)
+
+var Scopes = []string{Required, Optional, Excluded}
+
+// Types lists the cyclonedx component types followed by the spdx primary
+// package purposes which are not one of them.
+var Types = []string{
+	"application", "framework", "library", "container", "platform", "operating-system",
+	"device", "device-driver", "firmware", "file", "machine-learning-model", "data",
+	"cryptographic-asset", "source", "archive", "install", "other",
+}
+
+// Filter keeps the components whose scope and type are included and not
+// excluded, an empty list includes every scope or type.
+type Filter struct {
+	IncludeScopes []string `yaml:"include_scopes,omitempty"`
+	ExcludeScopes []string `yaml:"exclude_scopes,omitempty"`
+	IncludeTypes  []string `yaml:"include_types,omitempty"`
+	ExcludeTypes  []string `yaml:"exclude_types,omitempty"`
+}
+
+// CheckMinComponents fails when the assembled sbom keeps fewer than min
+// components.
+func CheckMinComponents(count, min int) error {
+	if count < min {
+		return fmt.Errorf("assembled sbom has %d components, fewer than the required minimum of %d", count, min)
+	}
+	return nil
+}
+
+// Empty reports whether the filter keeps every component.
+func (f *Filter) Empty() bool {
+	return len(f.IncludeScopes) == 0 && len(f.ExcludeScopes) == 0 &&
+		len(f.IncludeTypes) == 0 && len(f.ExcludeTypes) == 0
+}
+
+// Normalize trims and lower cases the scopes and types and validates them.
+func (f *Filter) Normalize() error {
+	for _, l := range []*[]string{&f.IncludeScopes, &f.ExcludeScopes} {
+		*l = normalize(*l)
+		for _, s := range *l {
+			if !lo.Contains(Scopes, s) {
+				return fmt.Errorf("unsupported scope %s :: use one of these %+v", s, Scopes)
+			}
+		}
+	}
+
+	for _, l := range []*[]string{&f.IncludeTypes, &f.ExcludeTypes} {
+		*l = normalize(*l)
+		for _, t := range *l {
+			if !lo.Contains(Types, t) {
+				return fmt.Errorf("unsupported type %s :: use one of these %+v", t, Types)
+			}
+		}
+	}
+	return nil
+}
+
+// Keep reports whether a component of scope and type is kept. A component
+// without a scope is required, as in cyclonedx.
+func (f *Filter) Keep(scope, typ string) bool {
+	scope = strings.ToLower(strings.TrimSpace(scope))
+	if scope == "" {
+		scope = Required
+	}
+	typ = strings.ToLower(strings.TrimSpace(typ))
+
+	return keep(f.IncludeScopes, f.ExcludeScopes, scope) && keep(f.IncludeTypes, f.ExcludeTypes, typ)
+}
+
+func keep(include, exclude []string, value string) bool {
+	if len(include) > 0 && !lo.Contains(include, value) {
+		return false
+	}
+	return !lo.Contains(exclude, value)
+}
+
+func normalize(values []string) []string {
+	return lo.Uniq(lo.FilterMap(values, func(v string, _ int) (string, bool) {
+		v = strings.ToLower(strings.TrimSpace(v))
+		return v, v != ""
+	}))
+}
@@ -17,9 +17,16 @@
 package assemble
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"io"
+	"os"
 
 	"github.com/google/uuid"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/report"
+	"github.com/interlynk-io/sbomasm/pkg/logger"
+	"github.com/interlynk-io/sbomasm/pkg/sign"
 )
 
 type Params struct {
@@ -37,38 +44,250 @@ type Params struct {
 	Name    string
 	Version string
 	Type    string
+	Purl    string
+	CPE     string
 
 	FlatMerge     bool
 	HierMerge     bool
 	AssemblyMerge bool
+	DedupBy       string
+
+	// Authors and Supplier are given as "name <email>", they replace those of
+	// the config file
+	Authors  []string
+	Supplier string
+
+	// Timestamp is the RFC3339 creation time of the output, NoTimestamp
+	// leaves it out of CycloneDX output
+	Timestamp   string
+	NoTimestamp bool
+
+	// LowMemory streams the components of the inputs to the output instead
+	// of building the assembled sbom in memory, cyclonedx flat merge only
+	LowMemory bool
+
+	// StrictLicenses fails the assembly when a license can not be mapped to
+	// an spdx id, otherwise such licenses are kept as is
+	StrictLicenses bool
+
+	// IncludeScopes, ExcludeScopes, IncludeTypes and ExcludeTypes filter the
+	// deduplicated components by their scope and type, see the filter package
+	IncludeScopes []string
+	ExcludeScopes []string
+	IncludeTypes  []string
+	ExcludeTypes  []string
+
+	// MinComponents fails the assembly when the merged sbom has fewer
+	// components, FailOnEmpty requires at least one
+	MinComponents int
+	FailOnEmpty   bool
 
 	Xml  bool
 	Json bool
 
+	// Proto writes cyclonedx output as protobuf
+	Proto bool
+
+	// SpdxFormat is the file format of spdx output, json or tagvalue
+	SpdxFormat string
+
 	OutputSpec        string
 	OutputSpecVersion string
+
+	// OutputFormats writes the assembled sbom in several specs and formats
+	// from a single merge, each given as <spec>-<format>:<path> e.g.
+	// cdx-json:out.cdx.json, can not be combined with Output
+	OutputFormats []string
+
+	// Report is the path a json report of the merge is written to
+	Report string
+
+	// SignKey is the path of a PEM private key, each output file is signed
+	// with it and the detached JWS is written next to it with a .sig extension
+	SignKey string
+
+	// Validate checks the assembled sbom against the bundled json schema of
+	// the output spec version, an invalid sbom is not written. The sbom is
+	// held in memory until it is validated.
+	Validate bool
+
+	// MaxInputSize caps the decompressed size in bytes of each gzip or zip
+	// input, defaults to DEFAULT_MAX_INPUT_SIZE
+	MaxInputSize int64
 }
 
 func NewParams() *Params {
 	return &Params{}
 }
 
+// Assemble merges the input sboms of the config and writes the result to the
+// configured output file, or stdout when no output file is set. Missing parent
+// directories of the output file are created, and the output file is only
+// replaced once the merge succeeds.
 func Assemble(config *config) error {
-	err := config.validate()
+	if config == nil {
+		return fmt.Errorf("config is not set")
+	}
+
+	if len(config.Output.targets) > 0 {
+		return assembleTargets(config)
+	}
+
+	if config.Output.File == "" {
+		return AssembleToWriter(config, os.Stdout)
+	}
+
+	// The output is kept in memory as well when signing, so the signature
+	// covers the bytes written
+	out := newOutputFile(config.Output.File)
+	var data bytes.Buffer
+	var w io.Writer = out
+	if config.Output.signer != nil {
+		w = io.MultiWriter(out, &data)
+	}
+
+	if err := AssembleToWriter(config, w); err != nil {
+		out.abort()
+		return err
+	}
+
+	if config.Output.signer != nil {
+		if err := signOutput(config, config.Output.File, data.Bytes()); err != nil {
+			out.abort()
+			return err
+		}
+	}
+
+	if err := out.commit(); err != nil {
+		removeSignature(config, config.Output.File)
+		return err
+	}
+	return nil
+}
+
+// signOutput writes the signature of data, the sbom to be written to path,
+// next to it. Any previous signature is removed when signing fails, so it is
+// not left next to an sbom it does not cover.
+func signOutput(config *config, path string, data []byte) error {
+	sig, err := config.Output.signer.Sign(data)
+	if err == nil {
+		sigPath := sign.SignatureFile(path)
+		logger.FromContext(*config.ctx).Debugf("writing signature of %s to %s", path, sigPath)
+		err = WriteFile(sigPath, append(sig, '\n'))
+	}
 	if err != nil {
+		removeSignature(config, path)
+	}
+	return err
+}
+
+// removeSignature removes the signature of the output file at path, if any.
+func removeSignature(config *config, path string) {
+	if config.Output.signer == nil {
+		return
+	}
+	if err := os.Remove(sign.SignatureFile(path)); err != nil && !os.IsNotExist(err) {
+		logger.FromContext(*config.ctx).Warnf("unable to remove signature of %s: %v", path, err)
+	}
+}
+
+// WriteFile writes an assembled sbom to path the way Assemble does, creating
+// missing parent directories and only replacing path once data is written.
+func WriteFile(path string, data []byte) error {
+	out := newOutputFile(path)
+	if _, err := out.Write(data); err != nil {
+		out.abort()
 		return err
 	}
+	return out.commit()
+}
+
+// AssembleToWriter merges the input sboms of the config and writes the result
+// to w. The output file of the config is ignored. When the config uploads the
+// result to Dependency Track nothing is written to w. The report, when
+// requested, is written once the merge succeeds. Files extracted from compressed
+// inputs are removed on return, so a config is assembled only once.
+func AssembleToWriter(config *config, w io.Writer) error {
+	defer config.cleanup()
+
+	if !config.validated {
+		if err := config.validate(); err != nil {
+			return err
+		}
+	}
+
+	if config.Output.reportFile != "" && config.report == nil {
+		config.report = report.New()
+	}
 
 	cb := newCombiner(config)
 
-	err = cb.canCombine()
+	err := cb.canCombine()
 	if err != nil {
 		return err
 	}
 
-	err = cb.combine()
+	// A validated sbom is only written once it passes
+	out := w
+	var buf bytes.Buffer
+	if config.Output.Validate {
+		out = &buf
+	}
+
+	err = cb.combine(out)
 	if err != nil {
+		if ctx := *config.ctx; ctx.Err() != nil {
+			return fmt.Errorf("assembly stopped: %w", context.Cause(ctx))
+		}
 		return err
 	}
+
+	if config.Output.Validate {
+		if err := validateOutput(config, config.Output.Spec, config.Output.SpecVersion, buf.Bytes()); err != nil {
+			return err
+		}
+
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	if config.input.archives != nil {
+		config.report.RenameFiles(config.input.archives.names)
+	}
+
+	if config.Output.reportFile != "" {
+		var rep bytes.Buffer
+		if err := config.report.Write(&rep); err != nil {
+			return err
+		}
+		return WriteFile(config.Output.reportFile, rep.Bytes())
+	}
 	return nil
 }
+
+// DryRun performs the merge in memory without writing or uploading the
+// result, and returns a report of what would have been assembled.
+func DryRun(config *config) (*report.Report, error) {
+	if config == nil {
+		return nil, fmt.Errorf("config is not set")
+	}
+
+	config.report = report.New()
+	config.Output.Upload = false
+
+	if err := AssembleToWriter(config, io.Discard); err != nil {
+		return nil, err
+	}
+	return config.report, nil
+}
+
+// AssembleBytes merges the input sboms of the config and returns the result.
+func AssembleBytes(config *config) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := AssembleToWriter(config, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
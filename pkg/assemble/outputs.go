@@ -0,0 +1,308 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assemble
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	cydx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/cdx"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/spdx"
+	"github.com/interlynk-io/sbomasm/pkg/convert"
+	"github.com/interlynk-io/sbomasm/pkg/logger"
+	"github.com/interlynk-io/sbomasm/pkg/schema"
+	"github.com/samber/lo"
+	spdx_json "github.com/spdx/tools-golang/json"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+// stdoutTarget is the path of an output target written to stdout.
+const stdoutTarget = "-"
+
+// outputTarget is one of several outputs written from a single merge.
+type outputTarget struct {
+	Spec        string
+	SpecVersion string
+	FileFormat  string
+	File        string
+}
+
+func (t outputTarget) String() string {
+	return fmt.Sprintf("%s %s %s", t.Spec, t.SpecVersion, t.FileFormat)
+}
+
+var targetFileFormats = map[string][]string{
+	"cyclonedx": {"json", "xml", "proto"},
+	"spdx":      {"json", "tagvalue"},
+}
+
+// parseOutputTargets parses outputs given as <spec>-<format>:<path>, e.g.
+// cdx-json:out.cdx.json, and checks that no two of them write to the same
+// path. A path of - writes to stdout.
+func parseOutputTargets(values []string) ([]outputTarget, error) {
+	targets := []outputTarget{}
+	paths := map[string]string{}
+
+	for _, v := range values {
+		kind, path, found := strings.Cut(strings.TrimSpace(v), ":")
+		path = strings.TrimSpace(path)
+		if !found || path == "" {
+			return nil, fmt.Errorf("invalid output format %s, expected <spec>-<format>:<path> e.g cdx-json:out.cdx.json", v)
+		}
+
+		specName, format, _ := strings.Cut(strings.ToLower(strings.TrimSpace(kind)), "-")
+		spec := ""
+		switch specName {
+		case "cdx", "cyclonedx":
+			spec = "cyclonedx"
+		case "spdx":
+			spec = "spdx"
+		default:
+			return nil, fmt.Errorf("unsupported output spec %s in %s, expected cdx or spdx", specName, v)
+		}
+
+		if !lo.Contains(targetFileFormats[spec], format) {
+			return nil, fmt.Errorf("unsupported %s output format %s in %s, expected one of %+v", spec, format, v, targetFileFormats[spec])
+		}
+
+		key := path
+		if path != stdoutTarget {
+			abs, err := filepath.Abs(path)
+			if err != nil {
+				return nil, err
+			}
+			key = abs
+		}
+		if prev, ok := paths[key]; ok {
+			return nil, fmt.Errorf("outputs %s and %s both write to %s", prev, v, path)
+		}
+		paths[key] = v
+
+		targets = append(targets, outputTarget{Spec: spec, FileFormat: format, File: path})
+	}
+
+	return targets, nil
+}
+
+// mergeSpec returns the spec the inputs are merged in when writing output
+// targets, the spec of the inputs when a target has it, so that as little as
+// possible is converted, otherwise the spec of the first target.
+func (c *config) mergeSpec(inputSpecs []string) outputTarget {
+	if len(inputSpecs) == 1 {
+		if t, ok := lo.Find(c.Output.targets, func(t outputTarget) bool { return t.Spec == inputSpecs[0] }); ok {
+			return t
+		}
+	}
+	return c.Output.targets[0]
+}
+
+// validateTargets resolves the spec version of each output target and checks
+// that it can be written and, when requested, validated. The cyclonedx
+// targets use the output spec version, spdx targets are always 2.3.
+func (c *config) validateTargets() error {
+	for i := range c.Output.targets {
+		t := &c.Output.targets[i]
+
+		switch t.Spec {
+		case "cyclonedx":
+			if t.SpecVersion == "" {
+				t.SpecVersion = DEFAULT_OUTPUT_SPEC_VERSION
+			}
+			if !cdx.ValidSpecVersion(t.SpecVersion) {
+				return fmt.Errorf("invalid cyclonedx spec version %s", t.SpecVersion)
+			}
+			if t.FileFormat == "proto" && !cdx.SupportsProto(t.SpecVersion) {
+				return fmt.Errorf("protobuf is not defined for cyclonedx %s", t.SpecVersion)
+			}
+		case "spdx":
+			t.SpecVersion = DEFAULT_SPDX_SPEC_VERSION
+		}
+
+		if !c.Output.Validate {
+			continue
+		}
+		if t.FileFormat != "json" {
+			return fmt.Errorf("schema validation requires json output, %s is %s", t.File, t.FileFormat)
+		}
+		if !schema.Supported(t.Spec, t.SpecVersion) {
+			return fmt.Errorf("no schema is bundled for %s %s", t.Spec, t.SpecVersion)
+		}
+	}
+	return nil
+}
+
+// assembleTargets merges the inputs once, as json of the output spec, and
+// writes every output target from the merged sbom. Targets of the other spec
+// are converted from it, so all of them describe the same content. The files
+// of the targets are only replaced once every target is written.
+func assembleTargets(config *config) error {
+	log := logger.FromContext(*config.ctx)
+
+	var merged bytes.Buffer
+	if err := AssembleToWriter(config, &merged); err != nil {
+		return err
+	}
+
+	var bom *cydx.BOM
+	var doc *v2_3.Document
+	var err error
+
+	switch config.Output.Spec {
+	case "cyclonedx":
+		bom = new(cydx.BOM)
+		err = cydx.NewBOMDecoder(bytes.NewReader(merged.Bytes()), cydx.BOMFileFormatJSON).Decode(bom)
+	case "spdx":
+		doc, err = spdx_json.Read(bytes.NewReader(merged.Bytes()))
+	}
+	if err != nil {
+		return fmt.Errorf("unable to read the merged sbom: %w", err)
+	}
+
+	files := []*outputFile{}
+	abort := func() {
+		for _, f := range files {
+			f.abort()
+		}
+	}
+
+	var stdout []byte
+	written := map[string][]byte{}
+	for _, t := range config.Output.targets {
+		var buf bytes.Buffer
+		if err := encodeTarget(config, t, bom, doc, &buf); err != nil {
+			abort()
+			return fmt.Errorf("unable to write %s output %s: %w", t, t.File, err)
+		}
+
+		if config.Output.Validate && t.Spec != config.Output.Spec {
+			if err := validateOutput(config, t.Spec, t.SpecVersion, buf.Bytes()); err != nil {
+				abort()
+				return err
+			}
+		}
+
+		log.Debugf("writing %s output to %s", t, t.File)
+		if t.File == stdoutTarget {
+			stdout = buf.Bytes()
+			continue
+		}
+
+		out := newOutputFile(t.File)
+		files = append(files, out)
+		if _, err := out.Write(buf.Bytes()); err != nil {
+			abort()
+			return err
+		}
+		written[t.File] = buf.Bytes()
+	}
+
+	// The signatures are written first, so an sbom is only replaced once it
+	// is signed. The signatures of sboms which are not replaced are removed.
+	unsign := func(files []*outputFile) {
+		for _, f := range files {
+			removeSignature(config, f.path)
+		}
+	}
+	if config.Output.signer != nil {
+		for _, t := range config.Output.targets {
+			data, ok := written[t.File]
+			if !ok {
+				continue
+			}
+			if err := signOutput(config, t.File, data); err != nil {
+				abort()
+				unsign(files)
+				return err
+			}
+		}
+	}
+
+	for i, f := range files {
+		if err := f.commit(); err != nil {
+			abort()
+			unsign(files[i:])
+			return err
+		}
+	}
+
+	if stdout != nil {
+		_, err = os.Stdout.Write(stdout)
+	}
+	return err
+}
+
+// encodeTarget writes the merged bom or doc as the target, converting it when
+// the target is of the other spec.
+func encodeTarget(config *config, t outputTarget, bom *cydx.BOM, doc *v2_3.Document, w io.Writer) error {
+	log := logger.FromContext(*config.ctx)
+
+	var dropped convert.Dropped
+	var err error
+
+	switch {
+	case t.Spec == "cyclonedx" && bom == nil:
+		bom, dropped, err = convert.SpdxToCdx(doc)
+	case t.Spec == "spdx" && doc == nil:
+		doc, dropped, err = convert.CdxToSpdx(bom)
+	}
+	if err != nil {
+		return err
+	}
+
+	fields := lo.Keys(dropped)
+	sort.Strings(fields)
+	for _, field := range fields {
+		log.Warnf("%s output %s drops %d %s values with no %s equivalent", t.Spec, t.File, dropped[field], field, t.Spec)
+	}
+
+	if t.Spec == "cyclonedx" {
+		return cdx.Encode(w, bom, t.FileFormat, t.SpecVersion, log)
+	}
+	return spdx.Encode(w, doc, t.FileFormat)
+}
+
+// validateSigning checks that every output is written to a file when signing,
+// a signature is only written next to a file.
+func (c *config) validateSigning() error {
+	if c.Output.signer == nil {
+		return nil
+	}
+
+	if c.Output.Upload {
+		return fmt.Errorf("signing can not be combined with uploading the sbom")
+	}
+
+	if len(c.Output.targets) == 0 {
+		if c.Output.File == "" {
+			return fmt.Errorf("signing requires an output file")
+		}
+		return nil
+	}
+
+	for _, t := range c.Output.targets {
+		if t.File == stdoutTarget {
+			return fmt.Errorf("signing requires every output to be a file, %s output is written to stdout", t)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,435 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assemble
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+	"unicode"
+
+	cydx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/cdx/proto/v1_4"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/cdx/proto/v1_5"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/cdx/proto/v1_6"
+	"github.com/interlynk-io/sbomasm/pkg/logger"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestAssembleProtoRoundTrip assembles the fixtures as json and as protobuf of
+// each spec version, unmarshals the protobuf with the messages generated from
+// the schema of that version and expects the same bom as the json output.
+func TestAssembleProtoRoundTrip(t *testing.T) {
+	t.Setenv("SOURCE_DATE_EPOCH", "1700000000")
+	ctx := logger.WithLogger(context.Background())
+
+	boms := map[string]func() proto.Message{
+		"1.4": func() proto.Message { return &v1_4.Bom{} },
+		"1.5": func() proto.Message { return &v1_5.Bom{} },
+		"1.6": func() proto.Message { return &v1_6.Bom{} },
+	}
+
+	for version, newBom := range boms {
+		t.Run(version, func(t *testing.T) {
+			assemble := func(protobuf bool) []byte {
+				p := NewParams()
+				p.Ctx = &ctx
+				p.Name = "round-trip"
+				p.Version = "1.0.0"
+				p.Type = "application"
+				p.AssemblyMerge = true
+				p.OutputSpecVersion = version
+				p.Json = !protobuf
+				p.Proto = protobuf
+				for _, f := range []string{"app-a", "app-b", "app-e"} {
+					p.Input = append(p.Input, filepath.Join("testdata", f+".cdx.json"))
+				}
+
+				config, err := PopulateConfig(p)
+				if err != nil {
+					t.Fatalf("populate config: %v", err)
+				}
+				var out bytes.Buffer
+				if err := AssembleToWriter(config, &out); err != nil {
+					t.Fatalf("assemble: %v", err)
+				}
+				return out.Bytes()
+			}
+
+			jsonBom := &cydx.BOM{}
+			if err := cydx.NewBOMDecoder(bytes.NewReader(assemble(false)), cydx.BOMFileFormatJSON).Decode(jsonBom); err != nil {
+				t.Fatalf("decode json: %v", err)
+			}
+
+			data := assemble(true)
+			pinned := newBom()
+			if err := proto.Unmarshal(data, pinned); err != nil {
+				t.Fatalf("unmarshal protobuf: %v", err)
+			}
+			checkSchema(t, pinned.ProtoReflect(), "bom")
+
+			// the compared fields have the same numbers in every version, so
+			// they are read with the 1.6 messages
+			pb := &v1_6.Bom{}
+			if err := proto.Unmarshal(data, pb); err != nil {
+				t.Fatalf("unmarshal protobuf: %v", err)
+			}
+
+			if pb.GetSpecVersion() != version {
+				t.Errorf("spec version = %s, want %s", pb.GetSpecVersion(), version)
+			}
+
+			enums := pinned.ProtoReflect().Descriptor().ParentFile().Enums()
+			want := jsonBomLines(jsonBom, enums)
+			got := protoBomLines(pb, pinned)
+			for _, line := range lo.Without(want, got...) {
+				t.Errorf("protobuf is missing %s", line)
+			}
+			for _, line := range lo.Without(got, want...) {
+				t.Errorf("protobuf has %s, which is not in the json output", line)
+			}
+			if len(want) < 50 {
+				t.Errorf("json output has %d lines to compare, want the fixtures in it", len(want))
+			}
+		})
+	}
+}
+
+// checkSchema expects every field and enum value of the message to be
+// defined by its schema
+func checkSchema(t *testing.T, m protoreflect.Message, path string) {
+	t.Helper()
+	if unknown := m.GetUnknown(); len(unknown) > 0 {
+		t.Errorf("%s has %d bytes of fields which are not in the schema", path, len(unknown))
+	}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := path + "." + string(fd.Name())
+		values := []protoreflect.Value{v}
+		if fd.IsList() {
+			values = values[:0]
+			for i := 0; i < v.List().Len(); i++ {
+				values = append(values, v.List().Get(i))
+			}
+		}
+		for _, value := range values {
+			switch fd.Kind() {
+			case protoreflect.MessageKind:
+				checkSchema(t, value.Message(), name)
+			case protoreflect.EnumKind:
+				if fd.Enum().Values().ByNumber(value.Enum()) == nil {
+					t.Errorf("%s is %d, which is not a value of %s", name, value.Enum(), fd.Enum().Name())
+				}
+			}
+		}
+		return true
+	})
+}
+
+// enumKey compares an enumeration value of the json and protobuf output, it
+// keeps the letters and digits of the value in upper case
+func enumKey(value string) string {
+	return strings.Map(func(r rune) rune {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return -1
+		}
+		return unicode.ToUpper(r)
+	}, value)
+}
+
+// pinnedEnum is the key of the enumeration value in the enum of the schema,
+// values the schema does not define are written as its zero value
+func pinnedEnum(enums protoreflect.EnumDescriptors, enum, prefix, value string) string {
+	values := enums.ByName(protoreflect.Name(enum)).Values()
+	for i := 0; i < values.Len(); i++ {
+		if key := enumKey(strings.TrimPrefix(string(values.Get(i).Name()), prefix)); key == enumKey(value) {
+			return key
+		}
+	}
+	return enumKey(strings.TrimPrefix(string(values.ByNumber(0).Name()), prefix))
+}
+
+func protoEnumKey(value fmt.Stringer, prefix string) string {
+	return enumKey(strings.TrimPrefix(value.String(), prefix))
+}
+
+// jsonTime reads the timestamps of the json output, those of the fixtures are
+// RFC 3339 or a date
+func jsonTime(ts string) string {
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if t, err := time.Parse(layout, ts); err == nil {
+			return t.UTC().Format(time.RFC3339)
+		}
+	}
+	return ts
+}
+
+func protoTime(ts *timestamppb.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	return ts.AsTime().Format(time.RFC3339)
+}
+
+// jsonBomLines lists the compared parts of the json output one per line,
+// enums are the ones of the pinned schema
+func jsonBomLines(bom *cydx.BOM, enums protoreflect.EnumDescriptors) []string {
+	var lines []string
+	add := func(format string, args ...interface{}) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	licenses := func(key string, licenses *cydx.Licenses) {
+		for _, lc := range lo.FromPtr(licenses) {
+			switch {
+			case lc.License != nil && lc.License.ID != "":
+				add("%s license id %s", key, lc.License.ID)
+			case lc.License != nil:
+				add("%s license name %s", key, lc.License.Name)
+			default:
+				add("%s license expression %s", key, lc.Expression)
+			}
+		}
+	}
+
+	var component func(parent string, c cydx.Component)
+	component = func(parent string, c cydx.Component) {
+		key := c.BOMRef
+		add("%s in %s", key, parent)
+		add("%s %s %s/%s@%s", key, pinnedEnum(enums, "Classification", "CLASSIFICATION_", string(c.Type)), c.Group, c.Name, c.Version)
+		add("%s purl %s cpe %s", key, c.PackageURL, c.CPE)
+		add("%s description %s copyright %s", key, c.Description, c.Copyright)
+		add("%s scope %s", key, enumKey(string(c.Scope)))
+		if c.Supplier != nil {
+			add("%s supplier %s %v", key, c.Supplier.Name, lo.FromPtr(c.Supplier.URL))
+		}
+		for _, a := range lo.FromPtr(c.Authors) {
+			add("%s author %s", key, a.Name)
+		}
+		for _, h := range lo.FromPtr(c.Hashes) {
+			add("%s hash %s %s", key, pinnedEnum(enums, "HashAlg", "HASH_ALG_", string(h.Algorithm)), h.Value)
+		}
+		licenses(key, c.Licenses)
+		for _, r := range lo.FromPtr(c.ExternalReferences) {
+			add("%s reference %s %s", key, pinnedEnum(enums, "ExternalReferenceType", "EXTERNAL_REFERENCE_TYPE_", string(r.Type)), r.URL)
+		}
+		for _, p := range lo.FromPtr(c.Properties) {
+			add("%s property %s=%s", key, p.Name, p.Value)
+		}
+		for _, id := range lo.FromPtr(c.OmniborID) {
+			add("%s omnibor id %s", key, id)
+		}
+		for _, id := range lo.FromPtr(c.SWHID) {
+			add("%s swhid %s", key, id)
+		}
+		if s := c.SWID; s != nil {
+			add("%s swid %s %s %s", key, s.TagID, s.Name, s.Version)
+		}
+		if p := c.Pedigree; p != nil {
+			for _, a := range lo.FromPtr(p.Ancestors) {
+				add("%s ancestor %s@%s", key, a.Name, a.Version)
+			}
+			for _, cm := range lo.FromPtr(p.Commits) {
+				add("%s commit %s %s by %s at %s", key, cm.UID, cm.URL, cm.Author.Name, jsonTime(cm.Author.Timestamp))
+			}
+			add("%s pedigree notes %s", key, p.Notes)
+		}
+		if ev := c.Evidence; ev != nil {
+			licenses(key+" evidence", ev.Licenses)
+			for _, cp := range lo.FromPtr(ev.Copyright) {
+				add("%s evidence copyright %s", key, cp.Text)
+			}
+			for _, o := range lo.FromPtr(ev.Occurrences) {
+				add("%s occurrence %s line %d", key, o.Location, lo.FromPtr(o.Line))
+			}
+		}
+		if rn := c.ReleaseNotes; rn != nil {
+			add("%s release notes %s %s at %s %v", key, rn.Type, rn.Title, jsonTime(rn.Timestamp), lo.FromPtr(rn.Tags))
+		}
+		for _, child := range lo.FromPtr(c.Components) {
+			component(key, child)
+		}
+	}
+
+	add("bom %s version %d", bom.SerialNumber, bom.Version)
+	if md := bom.Metadata; md != nil {
+		add("timestamp %s", jsonTime(md.Timestamp))
+		for _, l := range lo.FromPtr(md.Lifecycles) {
+			add("lifecycle %s", enumKey(string(l.Phase)))
+		}
+		if md.Tools != nil {
+			for _, tool := range lo.FromPtr(md.Tools.Tools) {
+				add("tool %s %s %s", tool.Vendor, tool.Name, tool.Version)
+			}
+			for _, tool := range lo.FromPtr(md.Tools.Components) {
+				add("tool %s %s %s", tool.Publisher, tool.Name, tool.Version)
+			}
+		}
+		for _, a := range lo.FromPtr(md.Authors) {
+			add("author %s %s", a.Name, a.Email)
+		}
+		if md.Component != nil {
+			component("metadata", *md.Component)
+		}
+	}
+	for _, c := range lo.FromPtr(bom.Components) {
+		component("bom", c)
+	}
+	for _, s := range lo.FromPtr(bom.Services) {
+		add("service %s %s@%s %v", s.BOMRef, s.Name, s.Version, lo.FromPtr(s.Endpoints))
+		for _, d := range lo.FromPtr(s.Data) {
+			add("service %s data %s %s", s.BOMRef, enumKey(string(d.Flow)), d.Classification)
+		}
+	}
+	for _, d := range lo.FromPtr(bom.Dependencies) {
+		on := lo.FromPtr(d.Dependencies)
+		sort.Strings(on)
+		add("dependency %s -> %v", d.Ref, on)
+	}
+	return lines
+}
+
+// protoBomLines lists the parts of the protobuf output as jsonBomLines does,
+// the tools are read from the pinned message as they are a list before 1.5
+func protoBomLines(bom *v1_6.Bom, pinned proto.Message) []string {
+	var lines []string
+	add := func(format string, args ...interface{}) {
+		lines = append(lines, fmt.Sprintf(format, args...))
+	}
+
+	licenses := func(key string, licenses []*v1_6.LicenseChoice) {
+		for _, lc := range licenses {
+			switch {
+			case lc.GetLicense().GetId() != "":
+				add("%s license id %s", key, lc.GetLicense().GetId())
+			case lc.GetLicense() != nil:
+				add("%s license name %s", key, lc.GetLicense().GetName())
+			default:
+				add("%s license expression %s", key, lc.GetExpression())
+			}
+		}
+	}
+
+	var component func(parent string, c *v1_6.Component)
+	component = func(parent string, c *v1_6.Component) {
+		key := c.GetBomRef()
+		add("%s in %s", key, parent)
+		add("%s %s %s/%s@%s", key, protoEnumKey(c.GetType(), "CLASSIFICATION_"), c.GetGroup(), c.GetName(), c.GetVersion())
+		add("%s purl %s cpe %s", key, c.GetPurl(), c.GetCpe())
+		add("%s description %s copyright %s", key, c.GetDescription(), c.GetCopyright())
+		scope := ""
+		if c.Scope != nil {
+			scope = protoEnumKey(c.GetScope(), "SCOPE_")
+		}
+		add("%s scope %s", key, scope)
+		if s := c.GetSupplier(); s != nil {
+			add("%s supplier %s %v", key, s.GetName(), s.GetUrl())
+		}
+		for _, a := range c.GetAuthors() {
+			add("%s author %s", key, a.GetName())
+		}
+		for _, h := range c.GetHashes() {
+			add("%s hash %s %s", key, protoEnumKey(h.GetAlg(), "HASH_ALG_"), h.GetValue())
+		}
+		licenses(key, c.GetLicenses())
+		for _, r := range c.GetExternalReferences() {
+			add("%s reference %s %s", key, protoEnumKey(r.GetType(), "EXTERNAL_REFERENCE_TYPE_"), r.GetUrl())
+		}
+		for _, p := range c.GetProperties() {
+			add("%s property %s=%s", key, p.GetName(), p.GetValue())
+		}
+		for _, id := range c.GetOmniborId() {
+			add("%s omnibor id %s", key, id)
+		}
+		for _, id := range c.GetSwhid() {
+			add("%s swhid %s", key, id)
+		}
+		if s := c.GetSwid(); s != nil {
+			add("%s swid %s %s %s", key, s.GetTagId(), s.GetName(), s.GetVersion())
+		}
+		if p := c.GetPedigree(); p != nil {
+			for _, a := range p.GetAncestors() {
+				add("%s ancestor %s@%s", key, a.GetName(), a.GetVersion())
+			}
+			for _, cm := range p.GetCommits() {
+				add("%s commit %s %s by %s at %s", key, cm.GetUid(), cm.GetUrl(), cm.GetAuthor().GetName(), protoTime(cm.GetAuthor().GetTimestamp()))
+			}
+			add("%s pedigree notes %s", key, p.GetNotes())
+		}
+		if ev := c.GetEvidence(); ev != nil {
+			licenses(key+" evidence", ev.GetLicenses())
+			for _, cp := range ev.GetCopyright() {
+				add("%s evidence copyright %s", key, cp.GetText())
+			}
+			for _, o := range ev.GetOccurrences() {
+				add("%s occurrence %s line %d", key, o.GetLocation(), o.GetLine())
+			}
+		}
+		if rn := c.GetReleaseNotes(); rn != nil {
+			add("%s release notes %s %s at %s %v", key, rn.GetType(), rn.GetTitle(), protoTime(rn.GetTimestamp()), rn.GetTags())
+		}
+		for _, child := range c.GetComponents() {
+			component(key, child)
+		}
+	}
+
+	add("bom %s version %d", bom.GetSerialNumber(), bom.GetVersion())
+	if md := bom.GetMetadata(); md != nil {
+		add("timestamp %s", protoTime(md.GetTimestamp()))
+		for _, l := range md.GetLifecycles() {
+			add("lifecycle %s", protoEnumKey(l.GetPhase(), "LIFECYCLE_PHASE_"))
+		}
+		if legacy, ok := pinned.(*v1_4.Bom); ok {
+			for _, tool := range legacy.GetMetadata().GetTools() {
+				add("tool %s %s %s", tool.GetVendor(), tool.GetName(), tool.GetVersion())
+			}
+		} else {
+			for _, tool := range md.GetTools().GetComponents() {
+				add("tool %s %s %s", tool.GetPublisher(), tool.GetName(), tool.GetVersion())
+			}
+		}
+		for _, a := range md.GetAuthors() {
+			add("author %s %s", a.GetName(), a.GetEmail())
+		}
+		if md.GetComponent() != nil {
+			component("metadata", md.GetComponent())
+		}
+	}
+	for _, c := range bom.GetComponents() {
+		component("bom", c)
+	}
+	for _, s := range bom.GetServices() {
+		add("service %s %s@%s %v", s.GetBomRef(), s.GetName(), s.GetVersion(), s.GetEndpoints())
+		for _, d := range s.GetData() {
+			add("service %s data %s %s", s.GetBomRef(), protoEnumKey(d.GetFlow(), "DATA_FLOW_"), d.GetValue())
+		}
+	}
+	for _, d := range bom.GetDependencies() {
+		on := lo.Map(d.GetDependencies(), func(on *v1_6.Dependency, _ int) string { return on.GetRef() })
+		sort.Strings(on)
+		add("dependency %s -> %v", d.GetRef(), on)
+	}
+	return lines
+}
@@ -0,0 +1,173 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package report collects statistics about an assemble run, the components
+// which were deduplicated, license conflicts between duplicates and fields
+// dropped while converting between specs.
+//
+// All methods are safe to call on a nil *Report, so the merges can record
+// unconditionally.
+package report
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+)
+
+type Report struct {
+	Summary          Summary           `json:"summary"`
+	Inputs           []Input           `json:"inputs"`
+	Duplicates       []Duplicate       `json:"duplicates"`
+	LicenseConflicts []LicenseConflict `json:"license_conflicts"`
+	DroppedFields    []DroppedField    `json:"dropped_fields"`
+}
+
+// Input describes a single input sbom as it was loaded.
+type Input struct {
+	File         string `json:"file"`
+	Spec         string `json:"spec"`
+	Format       string `json:"format"`
+	Converted    bool   `json:"converted"`
+	Components   int    `json:"components"`
+	Dependencies int    `json:"dependencies"`
+}
+
+// Duplicate is a component which was merged into a component seen earlier.
+type Duplicate struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+	// KeyType is the identity used to detect the duplicate e.g purl or name-version
+	KeyType string `json:"key_type"`
+	Key     string `json:"key"`
+	// File is the input the duplicate was found in, FirstSeen the input of the kept component
+	File      string `json:"file"`
+	FirstSeen string `json:"first_seen"`
+	Ref       string `json:"ref"`
+}
+
+// LicenseConflict is a duplicate component whose licenses differ from the
+// licenses of the kept component.
+type LicenseConflict struct {
+	Name     string   `json:"name"`
+	Version  string   `json:"version"`
+	Key      string   `json:"key"`
+	File     string   `json:"file"`
+	Kept     []string `json:"kept"`
+	Conflict []string `json:"conflict"`
+}
+
+// DroppedField counts the values of a field which were not carried over into
+// the output.
+type DroppedField struct {
+	File   string `json:"file"`
+	Field  string `json:"field"`
+	Count  int    `json:"count"`
+	Reason string `json:"reason"`
+}
+
+func New() *Report {
+	return &Report{
+		Inputs:           []Input{},
+		Duplicates:       []Duplicate{},
+		LicenseConflicts: []LicenseConflict{},
+		DroppedFields:    []DroppedField{},
+	}
+}
+
+func (r *Report) AddInput(in Input) {
+	if r == nil {
+		return
+	}
+	r.Inputs = append(r.Inputs, in)
+}
+
+func (r *Report) AddDuplicate(d Duplicate) {
+	if r == nil {
+		return
+	}
+	r.Duplicates = append(r.Duplicates, d)
+}
+
+func (r *Report) AddLicenseConflict(c LicenseConflict) {
+	if r == nil {
+		return
+	}
+	r.LicenseConflicts = append(r.LicenseConflicts, c)
+}
+
+// AddDropped records count values of field dropped from file, counts of the same
+// file and field are summed up.
+func (r *Report) AddDropped(file, field string, count int, reason string) {
+	if r == nil || count == 0 {
+		return
+	}
+	for i, d := range r.DroppedFields {
+		if d.File == file && d.Field == field {
+			r.DroppedFields[i].Count += count
+			return
+		}
+	}
+	r.DroppedFields = append(r.DroppedFields, DroppedField{File: file, Field: field, Count: count, Reason: reason})
+}
+
+// AddDroppedFields records the dropped counts of each field of fields.
+func (r *Report) AddDroppedFields(file string, fields map[string]int, reason string) {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		r.AddDropped(file, k, fields[k], reason)
+	}
+}
+
+// RenameFiles replaces the input paths found in names, e.g temporary files
+// extracted from an archive, with their mapped name.
+func (r *Report) RenameFiles(names map[string]string) {
+	if r == nil || len(names) == 0 {
+		return
+	}
+
+	rename := func(file *string) {
+		if name, ok := names[*file]; ok {
+			*file = name
+		}
+	}
+
+	for i := range r.Inputs {
+		rename(&r.Inputs[i].File)
+	}
+	for i := range r.Duplicates {
+		rename(&r.Duplicates[i].File)
+		rename(&r.Duplicates[i].FirstSeen)
+	}
+	for i := range r.LicenseConflicts {
+		rename(&r.LicenseConflicts[i].File)
+	}
+	for i := range r.DroppedFields {
+		rename(&r.DroppedFields[i].File)
+	}
+}
+
+// Write writes the report as json to w.
+func (r *Report) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
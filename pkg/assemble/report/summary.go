@@ -0,0 +1,53 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+)
+
+// Summary holds the totals of a merge, it is filled by the spec specific merges.
+type Summary struct {
+	Spec        string `json:"spec"`
+	SpecVersion string `json:"spec_version"`
+	MergeMode   string `json:"merge_mode"`
+
+	Inputs                 int `json:"inputs"`
+	Components             int `json:"components"`
+	DuplicateComponents    int `json:"duplicate_components"`
+	FilteredComponents     int `json:"filtered_components"`
+	Dependencies           int `json:"dependencies"`
+	UnresolvedDependencies int `json:"unresolved_dependencies"`
+}
+
+// Print writes a human readable summary to w.
+func (s *Summary) Print(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 1, ' ', 0)
+
+	fmt.Fprintf(tw, "spec:\t%s %s\n", s.Spec, s.SpecVersion)
+	fmt.Fprintf(tw, "merge mode:\t%s\n", s.MergeMode)
+	fmt.Fprintf(tw, "inputs:\t%d\n", s.Inputs)
+	fmt.Fprintf(tw, "components:\t%d\n", s.Components)
+	fmt.Fprintf(tw, "duplicate components:\t%d\n", s.DuplicateComponents)
+	fmt.Fprintf(tw, "filtered components:\t%d\n", s.FilteredComponents)
+	fmt.Fprintf(tw, "dependencies:\t%d\n", s.Dependencies)
+	fmt.Fprintf(tw, "unresolved dependency refs:\t%d\n", s.UnresolvedDependencies)
+
+	return tw.Flush()
+}
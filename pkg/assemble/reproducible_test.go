@@ -0,0 +1,83 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package assemble
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/interlynk-io/sbomasm/pkg/logger"
+)
+
+// TestAssembleReproducible assembles the same inputs twice with
+// SOURCE_DATE_EPOCH pinned and expects the same bytes both times.
+func TestAssembleReproducible(t *testing.T) {
+	t.Setenv("SOURCE_DATE_EPOCH", "1700000000")
+	ctx := logger.WithLogger(context.Background())
+
+	inputs := map[string][]string{
+		"cdx":  {"app-a.cdx.json", "app-b.cdx.json"},
+		"spdx": {"app-a.spdx.json", "app-b.spdx.json"},
+	}
+	modes := map[string]func(*Params){
+		"hierarchical": func(p *Params) { p.HierMerge = true },
+		"flat":         func(p *Params) { p.FlatMerge = true },
+		"assembly":     func(p *Params) { p.AssemblyMerge = true },
+	}
+
+	for spec, files := range inputs {
+		for _, outSpec := range []string{"cyclonedx", "spdx"} {
+			for mode, setMode := range modes {
+				t.Run(spec+"/"+outSpec+"/"+mode, func(t *testing.T) {
+					assemble := func() []byte {
+						p := NewParams()
+						p.Ctx = &ctx
+						p.Name = "reproducible"
+						p.Version = "1.0.0"
+						p.Type = "application"
+						p.Json = true
+						p.OutputSpec = outSpec
+						for _, f := range files {
+							p.Input = append(p.Input, filepath.Join("testdata", f))
+						}
+						setMode(p)
+
+						config, err := PopulateConfig(p)
+						if err != nil {
+							t.Fatalf("populate config: %v", err)
+						}
+						var out bytes.Buffer
+						if err := AssembleToWriter(config, &out); err != nil {
+							t.Fatalf("assemble: %v", err)
+						}
+						return out.Bytes()
+					}
+
+					first, second := assemble(), assemble()
+					if len(first) == 0 {
+						t.Fatal("assembled sbom is empty")
+					}
+					if !bytes.Equal(first, second) {
+						t.Errorf("assembling the same inputs twice gave different output:\n%s\n---\n%s", first, second)
+					}
+				})
+			}
+		}
+	}
+}
@@ -19,7 +19,10 @@ package spdx
 import (
 	"context"
 	"errors"
+	"io"
 
+	"github.com/interlynk-io/sbomasm/pkg/assemble/filter"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/report"
 	"github.com/spdx/tools-golang/spdx"
 )
 
@@ -92,7 +95,9 @@ type output struct {
 	FileFormat  string
 	Spec        string
 	SpecVersion string
+	Timestamp   string
 	File        string
+	Writer      io.Writer
 }
 
 type input struct {
@@ -106,6 +111,18 @@ type assemble struct {
 	FlatMerge                  bool
 	HierarchicalMerge          bool
 	AssemblyMerge              bool
+	DedupBy                    string
+	StrictLicenses             bool
+	Filter                     filter.Filter
+	MinComponents              int
+}
+
+// build is the version of sbomasm, with the commit and build date when they
+// were embedded at build time
+type build struct {
+	Version   string
+	GitCommit string
+	BuildDate string
 }
 
 type MergeSettings struct {
@@ -114,6 +131,20 @@ type MergeSettings struct {
 	Output   output
 	Input    input
 	Assemble assemble
+	Build    build
+
+	// Report is filled with the statistics of the merge when set
+	Report *report.Report
+}
+
+// Encode writes an assembled document in the file format, json or tagvalue.
+func Encode(w io.Writer, doc *spdx.Document, fileFormat string) error {
+	buf, err := encode(doc, fileFormat)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(buf)
+	return err
 }
 
 func Merge(ms *MergeSettings) error {
@@ -127,6 +158,8 @@ func Merge(ms *MergeSettings) error {
 	}
 
 	merger := newMerge(ms)
-	merger.loadBoms()
+	if err := merger.loadBoms(); err != nil {
+		return err
+	}
 	return merger.combinedMerge()
 }
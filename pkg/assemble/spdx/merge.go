@@ -17,8 +17,13 @@
 package spdx
 
 import (
-	"github.com/google/uuid"
+	"fmt"
+	"path/filepath"
+
+	"github.com/interlynk-io/sbomasm/pkg/assemble/filter"
 	"github.com/interlynk-io/sbomasm/pkg/logger"
+	"github.com/interlynk-io/sbomasm/pkg/progress"
+	"github.com/samber/lo"
 	"github.com/spdx/tools-golang/spdx"
 	"github.com/spdx/tools-golang/spdx/v2/common"
 )
@@ -28,6 +33,15 @@ type merge struct {
 	out           *spdx.Document
 	in            []*spdx.Document
 	rootPackageID string
+
+	// number of relationship refs which could not be resolved
+	unresolvedRefs int
+
+	// number of packages which were merged into an existing one
+	duplicates int
+
+	// ids derives the ids of the merged packages and files
+	ids *idGenerator
 }
 
 func newMerge(ms *MergeSettings) *merge {
@@ -35,18 +49,48 @@ func newMerge(ms *MergeSettings) *merge {
 		settings:      ms,
 		in:            []*spdx.Document{},
 		out:           &spdx.Document{},
-		rootPackageID: uuid.New().String(),
+		rootPackageID: rootPackageID(ms.App.Name, ms.App.Version),
+		ids:           newIDGenerator(),
 	}
 }
 
-func (m *merge) loadBoms() {
+// rootPackageID derives the id of the assembled root package from its name and
+// version, so that repeated assemblies use the same id.
+func rootPackageID(name, version string) string {
+	return stableUUID(fmt.Sprintf("%s@%s", name, version))
+}
+
+// loadBoms parses the input sboms, it stops when the context is canceled.
+func (m *merge) loadBoms() error {
+	ctx := *m.settings.Ctx
+	p := progress.FromContext(ctx)
+
+	p.Start("parsing sboms", len(m.settings.Input.Files))
+	defer p.Done()
+
 	for _, path := range m.settings.Input.Files {
-		bom, err := loadBom(*m.settings.Ctx, path)
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		bom, err := loadBom(ctx, path, m.settings.Report)
 		if err != nil {
-			panic(err) // TODO: return error instead of panic
+			return fmt.Errorf("unable to load %s: %w", path, err)
 		}
 		m.in = append(m.in, bom)
+		p.Step(filepath.Base(path))
+	}
+	return nil
+}
+
+func mergeMode(ms *MergeSettings) string {
+	switch {
+	case ms.Assemble.FlatMerge:
+		return "flat"
+	case ms.Assemble.AssemblyMerge:
+		return "assembly"
 	}
+	return "hierarchical"
 }
 
 func (m *merge) combinedMerge() error {
@@ -68,7 +112,8 @@ func (m *merge) combinedMerge() error {
 
 	log.Debugf("generated creation with %d creators, created_at %s and license version %s", len(ci.Creators), ci.Created, ci.LicenseListVersion)
 
-	doc.ExternalDocumentReferences = append(doc.ExternalDocumentReferences, externalDocumentRefs(m.in)...)
+	docRefs, docRefMapper := externalDocumentRefs(m.in)
+	doc.ExternalDocumentReferences = append(doc.ExternalDocumentReferences, docRefs...)
 
 	log.Debugf("added %d external document references", len(doc.ExternalDocumentReferences))
 
@@ -79,6 +124,11 @@ func (m *merge) combinedMerge() error {
 
 	log.Debugf("generated primary package: %s, version: %s", primaryPkg.PackageName, primaryPkg.PackageVersion)
 
+	err = normalizeLicenses(m)
+	if err != nil {
+		return err
+	}
+
 	pkgs, pkgMapper, err := genPackageList(m)
 	if err != nil {
 		return err
@@ -89,14 +139,21 @@ func (m *merge) combinedMerge() error {
 		return err
 	}
 
-	rels, err := genRelationships(m, pkgMapper, fileMapper)
+	rels, err := genRelationships(m, pkgMapper, fileMapper, docRefMapper)
 	if err != nil {
 		return err
 	}
 
+	// Packages are filtered once deduplicated, so that the relationships of
+	// all their duplicates decide their scope
+	pkgs, rels, dropped := filterPackages(m, pkgs, rels)
+	log.Debugf("filtered %d packages by scope and type", len(dropped))
+
 	otherLicenses := genOtherLicenses(m.in)
 
-	describedPkgs := getDescribedPkgs(m)
+	describedPkgs := lo.Filter(getDescribedPkgs(m, pkgMapper), func(id string, _ int) bool {
+		return !dropped[id]
+	})
 
 	//Add Packages to document
 	doc.Packages = append(doc.Packages, primaryPkg)
@@ -129,8 +186,7 @@ func (m *merge) combinedMerge() error {
 		log.Debugf("hierarchical merge is applied")
 		// Default to hierarchical merge
 		// Add relationships between primary package and described packages from merge sets
-		for _, dp := range describedPkgs {
-			currentPkgId := pkgMapper[dp]
+		for _, currentPkgId := range describedPkgs {
 			topLevelRels = append(topLevelRels, &spdx.Relationship{
 				RefA:                common.MakeDocElementID("", string(primaryPkg.PackageSPDXIdentifier)),
 				RefB:                common.MakeDocElementID("", currentPkgId),
@@ -145,6 +201,26 @@ func (m *merge) combinedMerge() error {
 	if len(rels) > 0 {
 		doc.Relationships = append(doc.Relationships, rels...)
 	}
+	doc.Relationships = uniqRelationships(doc.Relationships)
+
+	if m.settings.Report != nil {
+		s := &m.settings.Report.Summary
+		s.Spec = "spdx"
+		s.SpecVersion = m.settings.Output.SpecVersion
+		s.MergeMode = mergeMode(m.settings)
+		s.Inputs = len(m.in)
+		s.Components = len(pkgs)
+		s.DuplicateComponents = m.duplicates
+		s.FilteredComponents = len(dropped)
+		s.Dependencies = len(doc.Relationships)
+		s.UnresolvedDependencies = m.unresolvedRefs
+	}
+
+	if err := filter.CheckMinComponents(len(pkgs), m.settings.Assemble.MinComponents); err != nil {
+		return err
+	}
+
+	sortDocument(doc)
 
 	//Write the SBOM
 	err = writeSBOM(doc, m)
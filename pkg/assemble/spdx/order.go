@@ -0,0 +1,177 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+// The assembled document only depends on its inputs and settings: ids and the
+// namespace are derived instead of random and lists are sorted, so identical
+// assemblies with a fixed timestamp are byte identical.
+
+// stableUUID derives a uuid from seed.
+func stableUUID(seed string) string {
+	return uuid.NewSHA1(uuid.NameSpaceURL, []byte("sbomasm:"+seed)).String()
+}
+
+// idGenerator derives the ids of the merged elements from their id in the
+// input documents.
+type idGenerator struct {
+	used map[string]bool
+}
+
+func newIDGenerator() *idGenerator {
+	return &idGenerator{used: make(map[string]bool)}
+}
+
+// next returns the id for the element with the lookup key seed, seeds shared
+// by several elements still give different ids.
+func (g *idGenerator) next(prefix, seed string) common.ElementID {
+	id := fmt.Sprintf("%s-%s", prefix, stableUUID(seed))
+	for n := 1; g.used[id]; n++ {
+		id = fmt.Sprintf("%s-%s", prefix, stableUUID(fmt.Sprintf("%s#%d", seed, n)))
+	}
+	g.used[id] = true
+	return common.ElementID(id)
+}
+
+// namespaceSeed identifies an assembly by its settings and the content of its
+// inputs.
+func namespaceSeed(ms *MergeSettings) string {
+	h := sha256.New()
+
+	settings, _ := json.Marshal(struct {
+		App         app
+		Assemble    assemble
+		SpecVersion string
+		Timestamp   string
+	}{ms.App, ms.Assemble, ms.Output.SpecVersion, ms.Output.Timestamp})
+	h.Write(settings)
+
+	for _, path := range ms.Input.Files {
+		f, err := os.Open(path)
+		if err != nil {
+			fmt.Fprintf(h, "%s\n", path)
+			continue
+		}
+		io.Copy(h, f)
+		f.Close()
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// sortDocument sorts the packages, files, relationships and licenses of doc.
+// The primary package stays first and the describes relationships lead.
+func sortDocument(doc *v2_3.Document) {
+	for _, pkg := range doc.Packages {
+		sort.Strings(pkg.PackageLicenseInfoFromFiles)
+		sortChecksums(pkg.PackageChecksums)
+		sort.SliceStable(pkg.PackageExternalReferences, func(i, j int) bool {
+			a, b := pkg.PackageExternalReferences[i], pkg.PackageExternalReferences[j]
+			return compareStrings(
+				[]string{a.Category, a.RefType, a.Locator},
+				[]string{b.Category, b.RefType, b.Locator}) < 0
+		})
+	}
+
+	if len(doc.Packages) > 1 {
+		pkgs := doc.Packages[1:]
+		sort.SliceStable(pkgs, func(i, j int) bool {
+			return comparePackages(pkgs[i], pkgs[j]) < 0
+		})
+	}
+
+	for _, file := range doc.Files {
+		sort.Strings(file.LicenseInfoInFiles)
+		sortChecksums(file.Checksums)
+	}
+
+	sort.SliceStable(doc.Files, func(i, j int) bool {
+		a, b := doc.Files[i], doc.Files[j]
+		return compareStrings(
+			[]string{a.FileName, string(a.FileSPDXIdentifier)},
+			[]string{b.FileName, string(b.FileSPDXIdentifier)}) < 0
+	})
+
+	sort.SliceStable(doc.Relationships, func(i, j int) bool {
+		return compareRelationships(doc.Relationships[i], doc.Relationships[j]) < 0
+	})
+
+	sort.SliceStable(doc.OtherLicenses, func(i, j int) bool {
+		return doc.OtherLicenses[i].LicenseIdentifier < doc.OtherLicenses[j].LicenseIdentifier
+	})
+
+	sort.SliceStable(doc.ExternalDocumentReferences, func(i, j int) bool {
+		return doc.ExternalDocumentReferences[i].DocumentRefID < doc.ExternalDocumentReferences[j].DocumentRefID
+	})
+}
+
+// comparePackages orders packages by purl, then name and version. The id
+// breaks ties.
+func comparePackages(a, b *v2_3.Package) int {
+	purlA, _ := pkgPurlAndCpe(a)
+	purlB, _ := pkgPurlAndCpe(b)
+
+	return compareStrings(
+		[]string{purlA, a.PackageName, a.PackageVersion, string(a.PackageSPDXIdentifier)},
+		[]string{purlB, b.PackageName, b.PackageVersion, string(b.PackageSPDXIdentifier)})
+}
+
+func compareRelationships(a, b *v2_3.Relationship) int {
+	describes := func(r *v2_3.Relationship) string {
+		if r.Relationship == common.TypeRelationshipDescribe {
+			return "0"
+		}
+		return "1"
+	}
+
+	return compareStrings(
+		[]string{describes(a), elementKey(a.RefA), a.Relationship, elementKey(a.RefB)},
+		[]string{describes(b), elementKey(b.RefA), b.Relationship, elementKey(b.RefB)})
+}
+
+func elementKey(id common.DocElementID) string {
+	return fmt.Sprintf("%s:%s%s", id.DocumentRefID, id.ElementRefID, id.SpecialID)
+}
+
+func sortChecksums(checksums []common.Checksum) {
+	sort.SliceStable(checksums, func(i, j int) bool {
+		return checksums[i].Algorithm < checksums[j].Algorithm
+	})
+}
+
+// compareStrings compares a and b field by field.
+func compareStrings(a, b []string) int {
+	for i := range a {
+		if c := strings.Compare(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
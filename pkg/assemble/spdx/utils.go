@@ -17,6 +17,7 @@
 package spdx
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
 	"encoding/json"
@@ -24,16 +25,22 @@ import (
 	"io"
 	"net/url"
 	"os"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/google/uuid"
+	cydx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/dedup"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/filter"
+	"github.com/interlynk-io/sbomasm/pkg/assemble/report"
+	"github.com/interlynk-io/sbomasm/pkg/convert"
 	"github.com/interlynk-io/sbomasm/pkg/detect"
+	liclib "github.com/interlynk-io/sbomasm/pkg/licenses"
 	"github.com/interlynk-io/sbomasm/pkg/logger"
+	"github.com/interlynk-io/sbomasm/pkg/progress"
 	"github.com/mitchellh/copystructure"
-	"github.com/pingcap/log"
 	"github.com/samber/lo"
 	spdx_json "github.com/spdx/tools-golang/json"
 	spdx_rdf "github.com/spdx/tools-golang/rdf"
@@ -42,7 +49,6 @@ import (
 	"github.com/spdx/tools-golang/spdx/v2/v2_3"
 	spdx_tv "github.com/spdx/tools-golang/tagvalue"
 	spdx_yaml "github.com/spdx/tools-golang/yaml"
-	"sigs.k8s.io/release-utils/version"
 )
 
 const NOA = "NOASSERTION"
@@ -56,7 +62,7 @@ func validSpecVersion(specVersion string) bool {
 	return ok
 }
 
-func loadBom(ctx context.Context, path string) (*v2_3.Document, error) {
+func loadBom(ctx context.Context, path string, rep *report.Report) (*v2_3.Document, error) {
 	log := logger.FromContext(ctx)
 
 	var d *v2_3.Document
@@ -75,6 +81,21 @@ func loadBom(ctx context.Context, path string) (*v2_3.Document, error) {
 
 	log.Debugf("loading bom:%s spec:%s format:%s", path, spec, format)
 
+	if spec == detect.SBOMSpecCDX {
+		bom, err := readCdx(f, format)
+		if err != nil {
+			return nil, err
+		}
+		log.Debugf("converting cyclonedx bom:%s to spdx", path)
+		doc, dropped, err := convert.CdxToSpdx(bom)
+		if err != nil {
+			return nil, err
+		}
+		rep.AddDroppedFields(path, dropped, "no spdx equivalent")
+		rep.AddInput(inputReport(path, string(spec), string(format), true, doc))
+		return doc, nil
+	}
+
 	switch format {
 	case detect.FileFormatJSON:
 		d, err = spdx_json.Read(f)
@@ -93,9 +114,40 @@ func loadBom(ctx context.Context, path string) (*v2_3.Document, error) {
 		return nil, err
 	}
 
+	rep.AddInput(inputReport(path, string(spec), string(format), false, d))
+
 	return d, nil
 }
 
+func inputReport(path, spec, format string, converted bool, doc *v2_3.Document) report.Input {
+	return report.Input{
+		File:         path,
+		Spec:         spec,
+		Format:       format,
+		Converted:    converted,
+		Components:   len(doc.Packages),
+		Dependencies: len(doc.Relationships),
+	}
+}
+
+func readCdx(f io.Reader, format detect.FileFormat) (*cydx.BOM, error) {
+	var fileFormat cydx.BOMFileFormat
+	switch format {
+	case detect.FileFormatJSON:
+		fileFormat = cydx.BOMFileFormatJSON
+	case detect.FileFormatXML:
+		fileFormat = cydx.BOMFileFormatXML
+	default:
+		return nil, fmt.Errorf("unsupported cyclonedx format %s", format)
+	}
+
+	bom := new(cydx.BOM)
+	if err := cydx.NewBOMDecoder(f, fileFormat).Decode(bom); err != nil {
+		return nil, err
+	}
+	return bom, nil
+}
+
 func utcNowTime() string {
 	return time.Now().UTC().Format(time.RFC3339)
 }
@@ -127,9 +179,8 @@ func cloneRelationship(c *spdx.Relationship) (*spdx.Relationship, error) {
 	return relCopy.(*spdx.Relationship), nil
 }
 
-func composeNamespace(docName string) string {
-	uuid := uuid.New().String()
-	path := fmt.Sprintf("%s/%s-%s", "spdxdocs", docName, uuid)
+func composeNamespace(docName, seed string) string {
+	path := fmt.Sprintf("%s/%s-%s", "spdxdocs", docName, stableUUID("namespace:"+seed))
 	url := url.URL{
 		Scheme: "https",
 		Host:   "spdx.org",
@@ -138,27 +189,65 @@ func composeNamespace(docName string) string {
 	return url.String()
 }
 
-// If we are merging documents, which are included as external references, we should
-// remove those. As they are no longer external references.
-func externalDocumentRefs(docs []*v2_3.Document) []v2_3.ExternalDocumentRef {
+// externalDocumentRefs returns the external document references of all
+// documents, except those pointing to a document of the merge set. A mapping
+// from the lookup key of each reference to its id in the merged document is
+// returned as well, ids used by several documents for different URIs are
+// renamed.
+func externalDocumentRefs(docs []*v2_3.Document) ([]v2_3.ExternalDocumentRef, map[string]string) {
 	currentDocNamespaces := lo.Map(docs, func(doc *v2_3.Document, _ int) string {
 		return doc.DocumentNamespace
 	})
 
 	refs := []v2_3.ExternalDocumentRef{}
+	mapper := make(map[string]string)
+
+	// ids of the merged document by the URI they reference
+	uriToID := make(map[string]string)
+	usedIDs := make(map[string]bool)
 
 	for _, doc := range docs {
 		for _, ref := range doc.ExternalDocumentReferences {
-			if !lo.Contains(currentDocNamespaces, ref.URI) {
+			if lo.Contains(currentDocNamespaces, ref.URI) {
+				continue
+			}
+
+			oldID := trimDocumentRefPrefix(ref.DocumentRefID)
+			newID, ok := uriToID[ref.URI]
+			if !ok {
+				newID = oldID
+				for i := 1; usedIDs[newID]; i++ {
+					newID = fmt.Sprintf("%s-%d", oldID, i)
+				}
+				usedIDs[newID] = true
+				uriToID[ref.URI] = newID
+
+				ref.DocumentRefID = "DocumentRef-" + newID
 				refs = append(refs, ref)
 			}
+			mapper[createLookupKey(doc.DocumentNamespace, oldID)] = newID
 		}
 	}
 
-	return refs
+	return refs, mapper
+}
+
+func trimDocumentRefPrefix(id string) string {
+	return strings.TrimPrefix(id, "DocumentRef-")
+}
+
+// documentRefURI returns the URI of the external document reference declared
+// in doc with the given id.
+func documentRefURI(doc *v2_3.Document, docRefID string) string {
+	for _, ref := range doc.ExternalDocumentReferences {
+		if trimDocumentRefPrefix(ref.DocumentRefID) == docRefID {
+			return ref.URI
+		}
+	}
+	return ""
 }
 
-func getAllCreators(docs []*v2_3.Document, authors []Author) []common.Creator {
+func getAllCreators(docs []*v2_3.Document, authors []Author, supplier Supplier, b build) []common.Creator {
 	var creators []common.Creator
 	var uniqCreator = make(map[string]common.Creator)
 
@@ -189,16 +278,40 @@ func getAllCreators(docs []*v2_3.Document, authors []Author) []common.Creator {
 		})
 	}
 
+	if supplier.Name != "" {
+		supplierCreator := supplier.Name
+		if supplier.Email != "" {
+			supplierCreator = fmt.Sprintf("%s (%s)", supplier.Name, supplier.Email)
+		}
+		creators = append(creators, common.Creator{
+			CreatorType: "Organization",
+			Creator:     supplierCreator,
+		})
+	}
+
 	sbomAsmCreator := common.Creator{
 		CreatorType: "Tool",
-		Creator:     fmt.Sprintf("%s-%s", "sbomasm", version.GetVersionInfo().GitVersion),
+		Creator:     fmt.Sprintf("%s-%s", "sbomasm", b.Version),
 	}
 
 	creators = append(creators, sbomAsmCreator)
 	return creators
 }
 
-func getCreatorComments(docs []*v2_3.Document) string {
+// describe returns the version of the build followed by its commit and build
+// date when they are known.
+func (b build) describe() string {
+	parts := []string{b.Version}
+	if b.GitCommit != "" {
+		parts = append(parts, "commit "+b.GitCommit)
+	}
+	if b.BuildDate != "" {
+		parts = append(parts, "built "+b.BuildDate)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func getCreatorComments(docs []*v2_3.Document, dedupBy string, b build) string {
 	comments := lo.Uniq(lo.Map(docs, func(bom *spdx.Document, _ int) string {
 		if bom.CreationInfo != nil {
 			return bom.CreationInfo.CreatorComment
@@ -210,8 +323,8 @@ func getCreatorComments(docs []*v2_3.Document) string {
 		return doc.DocumentName
 	}))
 
-	sbomasmComment := fmt.Sprintf("Generated by sbomasm (%s) using %s",
-		version.GetVersionInfo().GitVersion, strings.Join(docNames, ", "))
+	sbomasmComment := fmt.Sprintf("Generated by sbomasm (%s) using %s, packages deduplicated by %s",
+		b.describe(), strings.Join(docNames, ", "), dedupBy)
 
 	finalComments := append([]string{sbomasmComment}, comments...)
 
@@ -283,7 +396,7 @@ func genSpdxDocument(ms *merge) (*v2_3.Document, error) {
 	doc.DataLicense = v2_3.DataLicense
 	doc.SPDXIdentifier = common.ElementID("DOCUMENT")
 	doc.DocumentName = ms.settings.App.Name
-	doc.DocumentNamespace = composeNamespace(ms.settings.App.Name)
+	doc.DocumentNamespace = composeNamespace(ms.settings.App.Name, namespaceSeed(ms.settings))
 
 	return &doc, nil
 }
@@ -292,13 +405,16 @@ func genCreationInfo(ms *merge) (*v2_3.CreationInfo, error) {
 	ci := v2_3.CreationInfo{}
 
 	//set UTC time
-	ci.Created = utcNowTime()
-	ci.CreatorComment = getCreatorComments(ms.in)
+	ci.Created = ms.settings.Output.Timestamp
+	if ci.Created == "" {
+		ci.Created = utcNowTime()
+	}
+	ci.CreatorComment = getCreatorComments(ms.in, ms.settings.Assemble.DedupBy, ms.settings.Build)
 	lVersions := getLicenseListVersion(ms.in)
 	if lVersions != "" {
 		ci.LicenseListVersion = lVersions
 	}
-	creators := getAllCreators(ms.in, ms.settings.App.Authors)
+	creators := getAllCreators(ms.in, ms.settings.App.Authors, ms.settings.App.Supplier, ms.settings.Build)
 	ci.Creators = append(ci.Creators, creators...)
 	return &ci, nil
 }
@@ -357,7 +473,7 @@ func genPrimaryPackage(ms *merge) (*v2_3.Package, error) {
 	if _, ok := spdx_strings_to_types[ms.settings.App.PrimaryPurpose]; ok {
 		pkg.PrimaryPackagePurpose = spdx_strings_to_types[ms.settings.App.PrimaryPurpose]
 	} else {
-		log.Warn(fmt.Sprintf("PrimaryPurpose %s is not a valid SPDX Package Purpose", ms.settings.App.PrimaryPurpose))
+		logger.FromContext(*ms.settings.Ctx).Warnf("PrimaryPurpose %s is not a valid SPDX Package Purpose", ms.settings.App.PrimaryPurpose)
 	}
 	if ms.settings.App.Purl != "" {
 		purl := spdx.PackageExternalReference{
@@ -387,16 +503,46 @@ func genPackageList(ms *merge) ([]*v2_3.Package, map[string]string, error) {
 	var pkgs []*v2_3.Package
 	mapper := make(map[string]string)
 
-	for _, doc := range ms.in {
+	// unique packages by their dedup key, and the input they were first seen in
+	uniqPkgs := make(map[string]*v2_3.Package)
+	firstSeen := make(map[string]string)
+
+	p := progress.FromContext(*ms.settings.Ctx)
+	p.Start("merging sboms", len(ms.in))
+	defer p.Done()
+
+	for i, doc := range ms.in {
+		file := ms.settings.Input.Files[i]
+		if err := (*ms.settings.Ctx).Err(); err != nil {
+			return nil, nil, err
+		}
+
 		for _, pkg := range doc.Packages {
+			purl, cpe := pkgPurlAndCpe(pkg)
+			key, keyType := dedup.Key(ms.settings.Assemble.DedupBy, dedup.Identity{
+				Purl:    purl,
+				CPE:     cpe,
+				Type:    pkg.PrimaryPackagePurpose,
+				Name:    pkg.PackageName,
+				Version: pkg.PackageVersion,
+			})
+			oldSpdxId := createLookupKey(doc.DocumentNamespace, string(pkg.PackageSPDXIdentifier))
+
+			if kept, ok := uniqPkgs[key]; ok && key != "" {
+				mapper[oldSpdxId] = string(kept.PackageSPDXIdentifier)
+				ms.duplicates++
+				recordDuplicate(ms, key, keyType, file, firstSeen[key], pkg, kept)
+				unionPkg(kept, pkg)
+				continue
+			}
+
 			//Clone the package
 			clone, err := clonePkg(pkg)
 			if err != nil {
 				return nil, nil, err
 			}
 
-			newSpdxId := common.ElementID(fmt.Sprintf("Package-%s", uuid.New().String()))
-			oldSpdxId := createLookupKey(doc.DocumentNamespace, string(pkg.PackageSPDXIdentifier))
+			newSpdxId := ms.ids.next("Package", oldSpdxId)
 
 			mapper[oldSpdxId] = string(newSpdxId)
 
@@ -415,14 +561,203 @@ func genPackageList(ms *merge) ([]*v2_3.Package, map[string]string, error) {
 
 			clone.Files = nil
 
+			if key != "" {
+				uniqPkgs[key] = clone
+				firstSeen[key] = file
+			}
+
 			//Add the package to the list
 			pkgs = append(pkgs, clone)
 		}
+		p.Step(filepath.Base(file))
 	}
 
 	return pkgs, mapper, nil
 }
 
+// normalizeLicenses maps the licenses of the packages and files of the input
+// documents to spdx ids, so that duplicate packages compare and merge them by
+// id. Licenses which can not be mapped are kept as is, a strict assembly fails
+// on them.
+func normalizeLicenses(ms *merge) error {
+	unmapped := map[string]bool{}
+
+	normalize := func(element, value string) string {
+		if strings.TrimSpace(value) == "" {
+			return value
+		}
+		norm, ok := liclib.Normalize(value)
+		if !ok {
+			unmapped[fmt.Sprintf("%s: %s", element, value)] = true
+		}
+		return norm
+	}
+
+	normalizeAll := func(element string, values []string) []string {
+		return lo.Uniq(lo.Map(values, func(v string, _ int) string {
+			return normalize(element, v)
+		}))
+	}
+
+	normalizeFile := func(f *v2_3.File) {
+		f.LicenseConcluded = normalize(f.FileName, f.LicenseConcluded)
+		f.LicenseInfoInFiles = normalizeAll(f.FileName, f.LicenseInfoInFiles)
+	}
+
+	for _, doc := range ms.in {
+		for _, pkg := range doc.Packages {
+			element := fmt.Sprintf("%s@%s", pkg.PackageName, pkg.PackageVersion)
+			pkg.PackageLicenseDeclared = normalize(element, pkg.PackageLicenseDeclared)
+			pkg.PackageLicenseConcluded = normalize(element, pkg.PackageLicenseConcluded)
+			pkg.PackageLicenseInfoFromFiles = normalizeAll(element, pkg.PackageLicenseInfoFromFiles)
+			for _, f := range pkg.Files {
+				normalizeFile(f)
+			}
+		}
+		for _, f := range doc.Files {
+			normalizeFile(f)
+		}
+	}
+
+	if len(unmapped) == 0 {
+		return nil
+	}
+
+	if !ms.settings.Assemble.StrictLicenses {
+		logger.FromContext(*ms.settings.Ctx).Debugf("%d licenses could not be mapped to spdx ids, they are kept as is", len(unmapped))
+		return nil
+	}
+
+	licenses := lo.Keys(unmapped)
+	sort.Strings(licenses)
+	return fmt.Errorf("licenses could not be mapped to spdx ids: %s", strings.Join(licenses, "; "))
+}
+
+func pkgPurlAndCpe(pkg *v2_3.Package) (string, string) {
+	purl, cpe := "", ""
+	for _, ref := range pkg.PackageExternalReferences {
+		if ref == nil {
+			continue
+		}
+		switch {
+		case purl == "" && strings.EqualFold(ref.RefType, common.TypePackageManagerPURL):
+			purl = ref.Locator
+		case cpe == "" && (strings.EqualFold(ref.RefType, common.TypeSecurityCPE23Type) ||
+			strings.EqualFold(ref.RefType, common.TypeSecurityCPE22Type)):
+			cpe = ref.Locator
+		}
+	}
+	return purl, cpe
+}
+
+func recordDuplicate(ms *merge, key, keyType, file, firstSeen string, dup, kept *v2_3.Package) {
+	rep := ms.settings.Report
+	if rep == nil {
+		return
+	}
+
+	rep.AddDuplicate(report.Duplicate{
+		Name:      dup.PackageName,
+		Version:   dup.PackageVersion,
+		KeyType:   keyType,
+		Key:       key,
+		File:      file,
+		FirstSeen: firstSeen,
+		Ref:       string(kept.PackageSPDXIdentifier),
+	})
+
+	dupLicense := declaredLicense(dup)
+	keptLicense := declaredLicense(kept)
+	if dupLicense != "" && dupLicense != keptLicense {
+		rep.AddLicenseConflict(report.LicenseConflict{
+			Name:     dup.PackageName,
+			Version:  dup.PackageVersion,
+			Key:      key,
+			File:     file,
+			Kept:     []string{keptLicense},
+			Conflict: []string{dupLicense},
+		})
+	}
+}
+
+func declaredLicense(pkg *v2_3.Package) string {
+	l := strings.TrimSpace(pkg.PackageLicenseDeclared)
+	if l == NOA || l == "NONE" {
+		return ""
+	}
+	return l
+}
+
+// unionPkg adds the licenses, checksums and external references of dup, which
+// are not already present, to kept. Differing license expressions are joined
+// with AND, a checksum is only added when kept has none of its algorithm.
+func unionPkg(kept, dup *v2_3.Package) {
+	kept.PackageLicenseDeclared = unionLicense(kept.PackageLicenseDeclared, dup.PackageLicenseDeclared)
+	kept.PackageLicenseConcluded = unionLicense(kept.PackageLicenseConcluded, dup.PackageLicenseConcluded)
+
+	refKey := func(r *v2_3.PackageExternalReference) string {
+		return fmt.Sprintf("%s-%s-%s", r.Category, r.RefType, r.Locator)
+	}
+	keptRefs := lo.Map(kept.PackageExternalReferences, func(r *v2_3.PackageExternalReference, _ int) string {
+		return refKey(r)
+	})
+	for _, r := range dup.PackageExternalReferences {
+		if r == nil || lo.Contains(keptRefs, refKey(r)) {
+			continue
+		}
+		ref := *r
+		kept.PackageExternalReferences = append(kept.PackageExternalReferences, &ref)
+		keptRefs = append(keptRefs, refKey(r))
+	}
+
+	keptAlgos := lo.Map(kept.PackageChecksums, func(c common.Checksum, _ int) common.ChecksumAlgorithm {
+		return c.Algorithm
+	})
+	for _, c := range dup.PackageChecksums {
+		if lo.Contains(keptAlgos, c.Algorithm) {
+			continue
+		}
+		kept.PackageChecksums = append(kept.PackageChecksums, c)
+		keptAlgos = append(keptAlgos, c.Algorithm)
+	}
+}
+
+func unionLicense(kept, dup string) string {
+	absent := func(l string) bool {
+		l = strings.TrimSpace(l)
+		return l == "" || l == NOA || l == "NONE"
+	}
+
+	if absent(dup) {
+		return kept
+	}
+	if absent(kept) {
+		return dup
+	}
+
+	wrap := func(l string) string {
+		if strings.Contains(l, " ") && !(strings.HasPrefix(l, "(") && strings.HasSuffix(l, ")")) {
+			return "(" + l + ")"
+		}
+		return l
+	}
+
+	for _, part := range strings.Split(kept, " AND ") {
+		if strings.Trim(part, "()") == strings.Trim(dup, "()") {
+			return kept
+		}
+	}
+	return fmt.Sprintf("%s AND %s", kept, wrap(dup))
+}
+
+// uniqRelationships removes relationships which became identical after
+// duplicate packages were merged.
+func uniqRelationships(rels []*v2_3.Relationship) []*v2_3.Relationship {
+	return lo.UniqBy(rels, func(r *v2_3.Relationship) string {
+		return fmt.Sprintf("%s:%s-%s-%s:%s", r.RefA.DocumentRefID, r.RefA.ElementRefID, r.Relationship, r.RefB.DocumentRefID, r.RefB.ElementRefID)
+	})
+}
+
 func genFileList(ms *merge) ([]*v2_3.File, map[string]string, error) {
 	var files []*v2_3.File
 	mapper := make(map[string]string)
@@ -436,8 +771,8 @@ func genFileList(ms *merge) ([]*v2_3.File, map[string]string, error) {
 				return nil, nil, err
 			}
 
-			newSpdxId := common.ElementID(fmt.Sprintf("File-%s", uuid.New().String()))
 			oldSpdxId := createLookupKey(doc.DocumentNamespace, string(file.FileSPDXIdentifier))
+			newSpdxId := ms.ids.next("File", oldSpdxId)
 
 			mapper[oldSpdxId] = string(newSpdxId)
 			clone.FileSPDXIdentifier = newSpdxId
@@ -455,8 +790,8 @@ func genFileList(ms *merge) ([]*v2_3.File, map[string]string, error) {
 					return nil, nil, err
 				}
 
-				newSpdxId := common.ElementID(fmt.Sprintf("File-%s", uuid.New().String()))
 				oldSpdxId := createLookupKey(doc.DocumentNamespace, string(file.FileSPDXIdentifier))
+				newSpdxId := ms.ids.next("File", oldSpdxId)
 
 				mapper[oldSpdxId] = string(newSpdxId)
 				clone.FileSPDXIdentifier = newSpdxId
@@ -470,16 +805,18 @@ func genFileList(ms *merge) ([]*v2_3.File, map[string]string, error) {
 	return files, mapper, nil
 }
 
-func genRelationships(ms *merge, pkgMapper map[string]string, fileMapper map[string]string) ([]*v2_3.Relationship, error) {
+// genRelationships returns the relationships of all documents, except the
+// describes relationships, with their elements resolved to the ids of the merged
+// document. Relationships with an element which cannot be resolved are dropped.
+func genRelationships(ms *merge, pkgMapper, fileMapper, docRefMapper map[string]string) ([]*v2_3.Relationship, error) {
 	var relationships []*v2_3.Relationship
 
-	docNames := lo.Map(ms.in, func(doc *v2_3.Document, _ int) string {
-		return doc.DocumentName
-	})
+	log := logger.FromContext(*ms.settings.Ctx)
 
 	for _, doc := range ms.in {
 		for _, rel := range doc.Relationships {
-			if rel.Relationship == common.TypeRelationshipDescribe {
+			if rel.Relationship == common.TypeRelationshipDescribe ||
+				rel.Relationship == common.TypeRelationshipDescribeBy {
 				continue
 			}
 
@@ -489,103 +826,224 @@ func genRelationships(ms *merge, pkgMapper map[string]string, fileMapper map[str
 				return nil, err
 			}
 
-			// if the relationship has a DocumentRef defined, and the
-			// document is part of the merge set, we should null it out.
-			if rel.RefA.DocumentRefID != "" {
-				if lo.Contains(docNames, rel.RefA.DocumentRefID) {
-					clone.RefA.DocumentRefID = ""
-				} else {
-					log.Warn(fmt.Sprintf("RefA: Could not find document name %s in the merge set", rel.RefA.DocumentRefID))
-				}
+			refA, okA := resolveElement(ms, doc, rel.RefA, pkgMapper, fileMapper, docRefMapper)
+			refB, okB := resolveElement(ms, doc, rel.RefB, pkgMapper, fileMapper, docRefMapper)
+			if !okA || !okB {
+				ms.unresolvedRefs++
+				log.Warnf("dropping relationship %s %s %s of %s, element not found in the merge set",
+					rel.RefA, rel.Relationship, rel.RefB, doc.DocumentName)
+				continue
 			}
 
-			if rel.RefB.DocumentRefID != "" {
-				if lo.Contains(docNames, rel.RefB.DocumentRefID) {
-					clone.RefB.DocumentRefID = ""
-				} else {
-					log.Warn(fmt.Sprintf("RefB: Could not find document name %s in the merge set", rel.RefB.DocumentRefID))
-				}
+			// packages which were deduplicated can end up related to themselves
+			if refA == refB && refA.SpecialID == "" {
+				continue
 			}
 
-			//Update ElementId RefA and RefB
-			if rel.RefA.ElementRefID != "" {
-				namespace := ""
-				if rel.RefA.DocumentRefID != "" {
-					namespace = getDocumentNamespace(rel.RefA.DocumentRefID, ms)
-				} else {
-					namespace = doc.DocumentNamespace
-				}
+			clone.RefA = refA
+			clone.RefB = refB
 
-				key := createLookupKey(namespace, string(rel.RefA.ElementRefID))
+			//Add the relationship to the list
+			relationships = append(relationships, clone)
+		}
+	}
 
-				if _, ok := pkgMapper[key]; ok {
-					clone.RefA.ElementRefID = common.ElementID(pkgMapper[key])
-				} else if _, ok := fileMapper[key]; ok {
-					clone.RefA.ElementRefID = common.ElementID(fileMapper[key])
-				} else {
-					log.Warn(fmt.Sprintf("RefA: Could not find element %s in the merge set", key))
-				}
-			}
+	return relationships, nil
+}
 
-			if rel.RefB.ElementRefID != "" {
-				namespace := ""
-				if rel.RefB.DocumentRefID != "" {
-					namespace = getDocumentNamespace(rel.RefB.DocumentRefID, ms)
-				} else {
-					namespace = doc.DocumentNamespace
-				}
+// pkgScopes derives the cyclonedx scope of packages from the relationships
+// making them a dependency. A package which is only a development, test or
+// build dependency is excluded, one which is only an optional dependency is
+// optional. Packages missing from the result are required.
+func pkgScopes(rels []*v2_3.Relationship) map[common.ElementID]string {
+	excluded := map[common.ElementID]bool{}
+	optional := map[common.ElementID]bool{}
+	required := map[common.ElementID]bool{}
+
+	for _, rel := range rels {
+		switch rel.Relationship {
+		case common.TypeRelationshipDevDependencyOf, common.TypeRelationshipTestDependencyOf,
+			common.TypeRelationshipBuildDependencyOf, common.TypeRelationshipDevToolOf,
+			common.TypeRelationshipTestOf, common.TypeRelationshipTestToolOf,
+			common.TypeRelationshipBuildToolOf:
+			excluded[rel.RefA.ElementRefID] = true
+		case common.TypeRelationshipOptionalDependencyOf, common.TypeRelationshipOptionalComponentOf:
+			optional[rel.RefA.ElementRefID] = true
+		case common.TypeRelationshipDependencyOf, common.TypeRelationshipRuntimeDependencyOf,
+			common.TypeRelationshipProvidedDependencyOf:
+			required[rel.RefA.ElementRefID] = true
+		case common.TypeRelationshipDependsOn:
+			required[rel.RefB.ElementRefID] = true
+		}
+	}
 
-				key := createLookupKey(namespace, string(rel.RefB.ElementRefID))
-				if _, ok := pkgMapper[key]; ok {
-					clone.RefB.ElementRefID = common.ElementID(pkgMapper[key])
-				} else if _, ok := fileMapper[key]; ok {
-					clone.RefB.ElementRefID = common.ElementID(fileMapper[key])
-				} else {
-					log.Warn(fmt.Sprintf("RefB: Could not find element %s in the merge set", key))
-				}
-			}
+	scopes := map[common.ElementID]string{}
+	for id := range excluded {
+		scopes[id] = filter.Excluded
+	}
+	for id := range optional {
+		scopes[id] = filter.Optional
+	}
+	for id := range required {
+		delete(scopes, id)
+	}
+	return scopes
+}
 
-			//Add the relationship to the list
-			relationships = append(relationships, clone)
+// filterPackages drops the packages which the filter of the merge does not
+// keep along with their relationships, and returns the ids of the dropped
+// packages. The type of a package is its primary package purpose.
+func filterPackages(ms *merge, pkgs []*v2_3.Package, rels []*v2_3.Relationship) ([]*v2_3.Package, []*v2_3.Relationship, map[string]bool) {
+	f := ms.settings.Assemble.Filter
+	dropped := map[string]bool{}
+	if f.Empty() {
+		return pkgs, rels, dropped
+	}
+
+	scopes := pkgScopes(rels)
+	pkgs = lo.Filter(pkgs, func(pkg *v2_3.Package, _ int) bool {
+		if f.Keep(scopes[pkg.PackageSPDXIdentifier], pkg.PrimaryPackagePurpose) {
+			return true
 		}
+		dropped[string(pkg.PackageSPDXIdentifier)] = true
+		return false
+	})
+
+	isDropped := func(id common.DocElementID) bool {
+		return id.DocumentRefID == "" && dropped[string(id.ElementRefID)]
 	}
+	rels = lo.Filter(rels, func(rel *v2_3.Relationship, _ int) bool {
+		return !isDropped(rel.RefA) && !isDropped(rel.RefB)
+	})
+	return pkgs, rels, dropped
+}
 
-	return relationships, nil
+// resolveElement maps an element of doc to its id in the merged document.
+// Elements of a document in the merge set, whether referenced directly or
+// through a DocumentRef, are mapped to their new ids. Elements of documents
+// outside the merge set keep their id, with the DocumentRef renamed as in
+// externalDocumentRefs.
+func resolveElement(ms *merge, doc *v2_3.Document, id common.DocElementID, pkgMapper, fileMapper, docRefMapper map[string]string) (common.DocElementID, bool) {
+	if id.SpecialID != "" {
+		return id, true
+	}
+
+	namespace := doc.DocumentNamespace
+	if id.DocumentRefID != "" {
+		uri := documentRefURI(doc, id.DocumentRefID)
+		if uri == "" {
+			// not declared by the document, fall back to matching the document name
+			uri = getDocumentNamespace(id.DocumentRefID, ms)
+		}
+
+		if !lo.ContainsBy(ms.in, func(d *v2_3.Document) bool { return d.DocumentNamespace == uri }) {
+			newRef, ok := docRefMapper[createLookupKey(doc.DocumentNamespace, id.DocumentRefID)]
+			if !ok {
+				return id, false
+			}
+			return common.MakeDocElementID(newRef, string(id.ElementRefID)), true
+		}
+		namespace = uri
+	}
+
+	if id.ElementRefID == "DOCUMENT" {
+		return common.MakeDocElementID("", "DOCUMENT"), true
+	}
+
+	key := createLookupKey(namespace, string(id.ElementRefID))
+	if newID, ok := pkgMapper[key]; ok {
+		return common.MakeDocElementID("", newID), true
+	}
+	if newID, ok := fileMapper[key]; ok {
+		return common.MakeDocElementID("", newID), true
+	}
+	return id, false
 }
 
-func getDescribedPkgs(ms *merge) []string {
+// getDescribedPkgs returns the new ids of the packages described by each
+// document. When a document does not describe any of its packages, its top
+// level packages, those not contained by or a dependency of another element,
+// are used instead.
+func getDescribedPkgs(ms *merge, pkgMapper map[string]string) []string {
 	pkgs := []string{}
 
+	log := logger.FromContext(*ms.settings.Ctx)
+
 	for _, doc := range ms.in {
+		described := []string{}
 		for _, rel := range doc.Relationships {
-			if rel.Relationship == common.TypeRelationshipDescribe {
-				if rel.RefB.ElementRefID != "" {
-					pkgs = append(pkgs, createLookupKey(doc.DocumentNamespace, string(rel.RefB.ElementRefID)))
-				}
+			elem := ""
+			switch rel.Relationship {
+			case common.TypeRelationshipDescribe:
+				elem = string(rel.RefB.ElementRefID)
+			case common.TypeRelationshipDescribeBy:
+				elem = string(rel.RefA.ElementRefID)
+			}
+
+			if newID, ok := pkgMapper[createLookupKey(doc.DocumentNamespace, elem)]; ok && elem != "" {
+				described = append(described, newID)
 			}
 		}
+
+		if len(described) == 0 {
+			described = topLevelPkgs(doc, pkgMapper)
+			log.Debugf("%s does not describe a package, using %d top level packages", doc.DocumentName, len(described))
+		}
+
+		pkgs = append(pkgs, described...)
+	}
+
+	return lo.Uniq(pkgs)
+}
+
+func topLevelPkgs(doc *v2_3.Document, pkgMapper map[string]string) []string {
+	nested := make(map[common.ElementID]bool)
+	for _, rel := range doc.Relationships {
+		if rel.RefA.DocumentRefID != "" || rel.RefB.DocumentRefID != "" || rel.RefA == rel.RefB {
+			continue
+		}
+
+		switch rel.Relationship {
+		case common.TypeRelationshipContains, common.TypeRelationshipDependsOn:
+			nested[rel.RefB.ElementRefID] = true
+		case common.TypeRelationshipContainedBy, common.TypeRelationshipDependencyOf:
+			nested[rel.RefA.ElementRefID] = true
+		}
 	}
 
-	return pkgs
+	return lo.FilterMap(doc.Packages, func(pkg *v2_3.Package, _ int) (string, bool) {
+		if nested[pkg.PackageSPDXIdentifier] {
+			return "", false
+		}
+		newID, ok := pkgMapper[createLookupKey(doc.DocumentNamespace, string(pkg.PackageSPDXIdentifier))]
+		return newID, ok
+	})
+}
+
+// encode returns doc as json or tag-value.
+func encode(doc *v2_3.Document, fileFormat string) ([]byte, error) {
+	if fileFormat == "tagvalue" {
+		var tv bytes.Buffer
+		err := spdx_tv.Write(doc, &tv)
+		return tv.Bytes(), err
+	}
+	return json.MarshalIndent(doc, "", " ")
 }
 
 func writeSBOM(doc *v2_3.Document, m *merge) error {
 	log := logger.FromContext(*m.settings.Ctx)
-	var f io.Writer
+	var f io.Writer = os.Stdout
 	outName := "stdout"
 
-	if m.settings.Output.File == "" {
-		f = os.Stdout
-	} else {
-		var err error
+	if m.settings.Output.File != "" {
 		outName = m.settings.Output.File
-		f, err = os.Create(m.settings.Output.File)
-		if err != nil {
-			return err
-		}
 	}
 
-	buf, err := json.MarshalIndent(doc, "", " ")
+	if m.settings.Output.Writer != nil {
+		f = m.settings.Output.Writer
+	}
+
+	buf, err := encode(doc, m.settings.Output.FileFormat)
 	if err != nil {
 		return err
 	}
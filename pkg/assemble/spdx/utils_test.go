@@ -0,0 +1,93 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+func TestUnionPkg(t *testing.T) {
+	sha1 := common.Checksum{Algorithm: common.SHA1, Value: "da39a3ee5e6b4b0d3255bfef95601890afd80709"}
+	sha256 := common.Checksum{Algorithm: common.SHA256, Value: "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"}
+	otherSha256 := common.Checksum{Algorithm: common.SHA256, Value: "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"}
+	purl := v2_3.PackageExternalReference{Category: common.CategoryPackageManager, RefType: common.TypePackageManagerPURL, Locator: "pkg:golang/example.com/foo@v1.0.0"}
+	cpe := v2_3.PackageExternalReference{Category: common.CategorySecurity, RefType: common.TypeSecurityCPE23Type, Locator: "cpe:2.3:a:example:foo:1.0.0:*:*:*:*:*:*:*"}
+
+	tests := []struct {
+		name string
+		kept v2_3.Package
+		dup  v2_3.Package
+		want v2_3.Package
+	}{
+		{
+			name: "checksums are added",
+			kept: v2_3.Package{PackageChecksums: []common.Checksum{sha1}},
+			dup:  v2_3.Package{PackageChecksums: []common.Checksum{sha256}},
+			want: v2_3.Package{PackageChecksums: []common.Checksum{sha1, sha256}},
+		},
+		{
+			name: "checksums are deduplicated by algorithm",
+			kept: v2_3.Package{PackageChecksums: []common.Checksum{sha256}},
+			dup:  v2_3.Package{PackageChecksums: []common.Checksum{otherSha256, sha1}},
+			want: v2_3.Package{PackageChecksums: []common.Checksum{sha256, sha1}},
+		},
+		{
+			name: "external references are added once",
+			kept: v2_3.Package{PackageExternalReferences: []*v2_3.PackageExternalReference{&purl}},
+			dup:  v2_3.Package{PackageExternalReferences: []*v2_3.PackageExternalReference{&purl, &cpe}},
+			want: v2_3.Package{PackageExternalReferences: []*v2_3.PackageExternalReference{&purl, &cpe}},
+		},
+		{
+			name: "declared licenses are joined with AND",
+			kept: v2_3.Package{PackageLicenseDeclared: "MIT"},
+			dup:  v2_3.Package{PackageLicenseDeclared: "MIT OR Apache-2.0"},
+			want: v2_3.Package{PackageLicenseDeclared: "MIT AND (MIT OR Apache-2.0)"},
+		},
+		{
+			name: "concluded licenses are added once",
+			kept: v2_3.Package{PackageLicenseConcluded: "MIT AND Apache-2.0"},
+			dup:  v2_3.Package{PackageLicenseConcluded: "Apache-2.0"},
+			want: v2_3.Package{PackageLicenseConcluded: "MIT AND Apache-2.0"},
+		},
+		{
+			name: "absent licenses are replaced",
+			kept: v2_3.Package{PackageLicenseDeclared: "NOASSERTION", PackageLicenseConcluded: "NONE"},
+			dup:  v2_3.Package{PackageLicenseDeclared: "MIT", PackageLicenseConcluded: "Apache-2.0"},
+			want: v2_3.Package{PackageLicenseDeclared: "MIT", PackageLicenseConcluded: "Apache-2.0"},
+		},
+		{
+			name: "absent licenses are ignored",
+			kept: v2_3.Package{PackageLicenseDeclared: "MIT"},
+			dup:  v2_3.Package{PackageLicenseDeclared: "NOASSERTION"},
+			want: v2_3.Package{PackageLicenseDeclared: "MIT"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kept := tt.kept
+			unionPkg(&kept, &tt.dup)
+			if !reflect.DeepEqual(kept, tt.want) {
+				t.Errorf("unionPkg() = %+v, want %+v", kept, tt.want)
+			}
+		})
+	}
+}
@@ -17,11 +17,20 @@
 package assemble
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/interlynk-io/sbomasm/pkg/detect"
+	"github.com/interlynk-io/sbomasm/pkg/logger"
+	"github.com/interlynk-io/sbomasm/pkg/schema"
+	"github.com/samber/lo"
 )
 
+// maxListedViolations caps the schema violations listed in the error.
+const maxListedViolations = 50
+
 func detectSbom(path string) (string, string, error) {
 	f, err := os.Open(path)
 	if err != nil {
@@ -35,3 +44,98 @@ func detectSbom(path string) (string, string, error) {
 	}
 	return string(spec), string(format), nil
 }
+
+// outputFile is an io.Writer which writes to a temporary file next to the
+// output file. The temporary file is only created on the first write and is
+// renamed over the output file on commit, so that neither an empty nor a
+// truncated file is left behind when assembling fails.
+type outputFile struct {
+	path string
+	f    *os.File
+}
+
+func newOutputFile(path string) *outputFile {
+	return &outputFile{path: path}
+}
+
+func (o *outputFile) Write(p []byte) (int, error) {
+	if o.f == nil {
+		dir := filepath.Dir(o.path)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return 0, err
+		}
+
+		f, err := os.CreateTemp(dir, "."+filepath.Base(o.path)+".*.tmp")
+		if err != nil {
+			return 0, err
+		}
+		o.f = f
+	}
+	return o.f.Write(p)
+}
+
+// commit moves the written sbom into place, keeping the mode of the file it
+// replaces.
+func (o *outputFile) commit() error {
+	if o.f == nil {
+		return nil
+	}
+
+	tmp := o.f.Name()
+	mode := os.FileMode(0o644)
+	if fi, err := os.Stat(o.path); err == nil {
+		mode = fi.Mode().Perm()
+	}
+
+	err := o.f.Sync()
+	if cerr := o.f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Chmod(tmp, mode)
+	}
+	if err == nil {
+		err = os.Rename(tmp, o.path)
+	}
+	if err != nil {
+		os.Remove(tmp)
+	}
+	o.f = nil
+	return err
+}
+
+// abort removes the temporary file, the output file is left untouched.
+func (o *outputFile) abort() {
+	if o.f == nil {
+		return
+	}
+	o.f.Close()
+	os.Remove(o.f.Name())
+	o.f = nil
+}
+
+// validateOutput checks the assembled sbom against the schema of the spec
+// version.
+func validateOutput(c *config, spec, specVersion string, doc []byte) error {
+	log := logger.FromContext(*c.ctx)
+
+	violations, err := schema.Validate(spec, specVersion, doc)
+	if err != nil {
+		return err
+	}
+
+	if len(violations) == 0 {
+		log.Debugf("assembled sbom is valid %s %s", spec, specVersion)
+		return nil
+	}
+
+	listed := lo.Map(lo.Slice(violations, 0, maxListedViolations), func(v schema.Violation, _ int) string {
+		return v.String()
+	})
+	if len(violations) > maxListedViolations {
+		listed = append(listed, fmt.Sprintf("... and %d more", len(violations)-maxListedViolations))
+	}
+
+	return fmt.Errorf("assembled sbom is not valid %s %s:\n  %s",
+		spec, specVersion, strings.Join(listed, "\n  "))
+}
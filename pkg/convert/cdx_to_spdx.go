@@ -0,0 +1,411 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	cydx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+// cdxToSpdx holds the state of a single CycloneDX to SPDX conversion.
+type cdxToSpdx struct {
+	doc *v2_3.Document
+
+	// ids maps a bom-ref to the SPDX id of the package created for it
+	ids     map[string]common.ElementID
+	usedIDs map[common.ElementID]bool
+
+	otherLicenses map[string]bool
+
+	dropped Dropped
+}
+
+// CdxToSpdx converts a CycloneDX bom into an SPDX 2.3 document.
+//
+// The metadata component and all components, including nested ones, become
+// packages. The metadata component is described by the document, nested
+// components are linked to their parent with CONTAINS and dependencies become
+// DEPENDS_ON relationships. The values which have no SPDX equivalent are
+// counted in the returned Dropped.
+func CdxToSpdx(bom *cydx.BOM) (*v2_3.Document, Dropped, error) {
+	if bom == nil {
+		return nil, nil, fmt.Errorf("cyclonedx bom is empty")
+	}
+
+	c := &cdxToSpdx{
+		ids:           map[string]common.ElementID{},
+		usedIDs:       map[common.ElementID]bool{},
+		otherLicenses: map[string]bool{},
+		dropped:       Dropped{},
+	}
+
+	c.dropped.add("services", len(lo.FromPtr(bom.Services)))
+	c.dropped.add("vulnerabilities", len(lo.FromPtr(bom.Vulnerabilities)))
+	c.dropped.add("compositions", len(lo.FromPtr(bom.Compositions)))
+	c.dropped.add("properties", len(lo.FromPtr(bom.Properties)))
+	c.dropped.add("annotations", len(lo.FromPtr(bom.Annotations)))
+	c.dropped.add("formulation", len(lo.FromPtr(bom.Formulation)))
+	c.dropped.add("externalReferences", len(lo.FromPtr(bom.ExternalReferences)))
+
+	var primary *cydx.Component
+	if bom.Metadata != nil {
+		primary = bom.Metadata.Component
+	}
+
+	name := "sbom"
+	if primary != nil && primary.Name != "" {
+		name = primary.Name
+		if primary.Version != "" {
+			name = fmt.Sprintf("%s-%s", primary.Name, primary.Version)
+		}
+	}
+
+	c.doc = &v2_3.Document{
+		SPDXVersion:       v2_3.Version,
+		DataLicense:       v2_3.DataLicense,
+		SPDXIdentifier:    common.ElementID("DOCUMENT"),
+		DocumentName:      name,
+		DocumentNamespace: cdxNamespace(name, bom),
+		CreationInfo:      cdxMetadataToCreationInfo(bom.Metadata),
+	}
+
+	described := []common.ElementID{}
+	if primary != nil {
+		described = append(described, c.addComponent(primary, ""))
+	}
+
+	for i := range lo.FromPtr(bom.Components) {
+		id := c.addComponent(&(*bom.Components)[i], "")
+		if primary == nil {
+			described = append(described, id)
+		}
+	}
+
+	for _, id := range described {
+		c.addRelationship("DOCUMENT", id, common.TypeRelationshipDescribe)
+	}
+
+	for _, dep := range lo.FromPtr(bom.Dependencies) {
+		from, ok := c.ids[dep.Ref]
+		if !ok {
+			continue
+		}
+		for _, d := range lo.FromPtr(dep.Dependencies) {
+			to, ok := c.ids[d]
+			if !ok || to == from {
+				c.dropped.add("dependencies", 1)
+				continue
+			}
+			c.addRelationship(from, to, common.TypeRelationshipDependsOn)
+		}
+	}
+
+	return c.doc, c.dropped, nil
+}
+
+// cdxNamespace derives the document namespace from the serial number of the
+// bom, or from its content when it has none.
+func cdxNamespace(name string, bom *cydx.BOM) string {
+	id := strings.TrimPrefix(bom.SerialNumber, "urn:uuid:")
+	if _, err := uuid.Parse(id); err != nil {
+		content, _ := json.Marshal(bom)
+		id = uuid.NewSHA1(uuid.NameSpaceURL, content).String()
+	}
+
+	u := url.URL{
+		Scheme: "https",
+		Host:   "spdx.org",
+		Path:   fmt.Sprintf("spdxdocs/%s-%s", name, id),
+	}
+	return u.String()
+}
+
+func cdxMetadataToCreationInfo(md *cydx.Metadata) *v2_3.CreationInfo {
+	ci := &v2_3.CreationInfo{
+		Created:  time.Now().UTC().Format(time.RFC3339),
+		Creators: []common.Creator{},
+	}
+	if md == nil {
+		return ci
+	}
+
+	if md.Timestamp != "" {
+		ci.Created = md.Timestamp
+	}
+
+	if md.Tools != nil {
+		for _, t := range lo.FromPtr(md.Tools.Tools) {
+			ci.Creators = append(ci.Creators, toolCreator(t.Name, t.Version))
+		}
+		for _, t := range lo.FromPtr(md.Tools.Components) {
+			ci.Creators = append(ci.Creators, toolCreator(t.Name, t.Version))
+		}
+		for _, t := range lo.FromPtr(md.Tools.Services) {
+			ci.Creators = append(ci.Creators, toolCreator(t.Name, t.Version))
+		}
+	}
+
+	for _, a := range lo.FromPtr(md.Authors) {
+		if a.Name == "" && a.Email == "" {
+			continue
+		}
+		ci.Creators = append(ci.Creators, common.Creator{
+			CreatorType: "Person",
+			Creator:     joinNameEmail(a.Name, a.Email),
+		})
+	}
+
+	for _, org := range []*cydx.OrganizationalEntity{md.Supplier, md.Manufacturer} {
+		if org != nil && org.Name != "" {
+			ci.Creators = append(ci.Creators, common.Creator{
+				CreatorType: "Organization",
+				Creator:     org.Name,
+			})
+		}
+	}
+
+	ci.Creators = lo.UniqBy(ci.Creators, func(c common.Creator) string {
+		return c.CreatorType + ":" + c.Creator
+	})
+
+	return ci
+}
+
+func toolCreator(name, version string) common.Creator {
+	creator := name
+	if version != "" {
+		creator = fmt.Sprintf("%s-%s", name, version)
+	}
+	return common.Creator{CreatorType: "Tool", Creator: creator}
+}
+
+// newID returns a unique SPDX id for a component, derived from its bom-ref when
+// possible.
+func (c *cdxToSpdx) newID(comp *cydx.Component) common.ElementID {
+	base := sanitizeSpdxID(strings.TrimPrefix(comp.BOMRef, "SPDXRef-"))
+	if base == "" {
+		base = sanitizeSpdxID(fmt.Sprintf("Package-%s-%s", comp.Name, comp.Version))
+	}
+	if base == "" {
+		base = "Package"
+	}
+
+	id := common.ElementID(base)
+	for i := 1; c.usedIDs[id]; i++ {
+		id = common.ElementID(fmt.Sprintf("%s-%d", base, i))
+	}
+	c.usedIDs[id] = true
+	return id
+}
+
+// addComponent adds a package for the component and all its nested components,
+// it returns the id of the package.
+func (c *cdxToSpdx) addComponent(comp *cydx.Component, parent common.ElementID) common.ElementID {
+	id := c.newID(comp)
+	if comp.BOMRef != "" {
+		if _, ok := c.ids[comp.BOMRef]; !ok {
+			c.ids[comp.BOMRef] = id
+		}
+	}
+
+	c.doc.Packages = append(c.doc.Packages, c.componentToPackage(comp, id))
+
+	if parent != "" {
+		c.addRelationship(parent, id, common.TypeRelationshipContains)
+	}
+
+	for i := range lo.FromPtr(comp.Components) {
+		c.addComponent(&(*comp.Components)[i], id)
+	}
+
+	return id
+}
+
+func (c *cdxToSpdx) addRelationship(from, to common.ElementID, relType string) {
+	c.doc.Relationships = append(c.doc.Relationships, &v2_3.Relationship{
+		RefA:         common.MakeDocElementID("", string(from)),
+		RefB:         common.MakeDocElementID("", string(to)),
+		Relationship: relType,
+	})
+}
+
+func (c *cdxToSpdx) componentToPackage(comp *cydx.Component, id common.ElementID) *v2_3.Package {
+	pkg := &v2_3.Package{
+		PackageName:             comp.Name,
+		PackageSPDXIdentifier:   id,
+		PackageVersion:          comp.Version,
+		PackageDownloadLocation: NOASSERTION,
+		FilesAnalyzed:           false,
+		PackageLicenseConcluded: NOASSERTION,
+		PackageLicenseDeclared:  NOASSERTION,
+		PackageCopyrightText:    NOASSERTION,
+		PackageDescription:      comp.Description,
+		PackageSupplier:         &common.Supplier{Supplier: NOASSERTION},
+	}
+
+	if purpose, ok := cdxToSpdxPurpose[comp.Type]; ok {
+		pkg.PrimaryPackagePurpose = purpose
+	} else if comp.Type != "" {
+		pkg.PrimaryPackagePurpose = "OTHER"
+	}
+
+	if comp.Supplier != nil && comp.Supplier.Name != "" {
+		pkg.PackageSupplier = &common.Supplier{
+			SupplierType: "Organization",
+			Supplier:     comp.Supplier.Name,
+		}
+	}
+
+	switch {
+	case comp.Author != "":
+		pkg.PackageOriginator = &common.Originator{OriginatorType: "Person", Originator: comp.Author}
+	case len(lo.FromPtr(comp.Authors)) > 0:
+		a := (*comp.Authors)[0]
+		pkg.PackageOriginator = &common.Originator{OriginatorType: "Person", Originator: joinNameEmail(a.Name, a.Email)}
+	case comp.Manufacturer != nil && comp.Manufacturer.Name != "":
+		pkg.PackageOriginator = &common.Originator{OriginatorType: "Organization", Originator: comp.Manufacturer.Name}
+	}
+
+	if comp.Copyright != "" {
+		pkg.PackageCopyrightText = comp.Copyright
+	}
+
+	for _, h := range lo.FromPtr(comp.Hashes) {
+		if alg, ok := cdxToSpdxHashAlgos[h.Algorithm]; ok {
+			pkg.PackageChecksums = append(pkg.PackageChecksums, common.Checksum{Algorithm: alg, Value: h.Value})
+		} else {
+			c.dropped.add("components.hashes", 1)
+		}
+	}
+
+	c.dropped.add("components.properties", len(lo.FromPtr(comp.Properties)))
+	if comp.Evidence != nil {
+		c.dropped.add("components.evidence", 1)
+	}
+	if comp.Pedigree != nil {
+		c.dropped.add("components.pedigree", 1)
+	}
+	if comp.SWID != nil {
+		c.dropped.add("components.swid", 1)
+	}
+
+	if lic := c.licenseExpression(comp.Licenses); lic != "" {
+		pkg.PackageLicenseDeclared = lic
+	}
+
+	for _, ref := range lo.FromPtr(comp.ExternalReferences) {
+		switch ref.Type {
+		case cydx.ERTypeDistribution, cydx.ERTypeVCS:
+			if pkg.PackageDownloadLocation == NOASSERTION {
+				pkg.PackageDownloadLocation = ref.URL
+				continue
+			}
+		case cydx.ERTypeWebsite:
+			if pkg.PackageHomePage == "" {
+				pkg.PackageHomePage = ref.URL
+				continue
+			}
+		}
+		c.dropped.add("components.externalReferences", 1)
+	}
+
+	if comp.PackageURL != "" {
+		pkg.PackageExternalReferences = append(pkg.PackageExternalReferences, &v2_3.PackageExternalReference{
+			Category: common.CategoryPackageManager,
+			RefType:  common.TypePackageManagerPURL,
+			Locator:  comp.PackageURL,
+		})
+	}
+
+	if comp.CPE != "" {
+		refType := common.TypeSecurityCPE22Type
+		if strings.HasPrefix(comp.CPE, "cpe:2.3:") {
+			refType = common.TypeSecurityCPE23Type
+		}
+		pkg.PackageExternalReferences = append(pkg.PackageExternalReferences, &v2_3.PackageExternalReference{
+			Category: common.CategorySecurity,
+			RefType:  refType,
+			Locator:  comp.CPE,
+		})
+	}
+
+	return pkg
+}
+
+// licenseExpression combines the licenses of a component into a single SPDX
+// expression. Licenses known only by name are added to the document as
+// LicenseRef- entries.
+func (c *cdxToSpdx) licenseExpression(licenses *cydx.Licenses) string {
+	parts := []string{}
+
+	for _, l := range lo.FromPtr(licenses) {
+		switch {
+		case l.Expression != "":
+			parts = append(parts, l.Expression)
+		case l.License != nil && l.License.ID != "":
+			parts = append(parts, l.License.ID)
+		case l.License != nil && l.License.Name != "":
+			parts = append(parts, c.otherLicenseRef(l.License))
+		}
+	}
+
+	parts = lo.Uniq(parts)
+	if len(parts) == 1 {
+		return parts[0]
+	}
+
+	return strings.Join(lo.Map(parts, func(p string, _ int) string {
+		if strings.Contains(p, " ") {
+			return "(" + p + ")"
+		}
+		return p
+	}), " AND ")
+}
+
+func (c *cdxToSpdx) otherLicenseRef(l *cydx.License) string {
+	id := fmt.Sprintf("LicenseRef-%s", sanitizeSpdxID(l.Name))
+	if c.otherLicenses[id] {
+		return id
+	}
+	c.otherLicenses[id] = true
+
+	text := l.Name
+	if l.Text != nil && l.Text.Content != "" && l.Text.Encoding == "" {
+		text = l.Text.Content
+	}
+
+	ol := &v2_3.OtherLicense{
+		LicenseIdentifier: id,
+		LicenseName:       l.Name,
+		ExtractedText:     text,
+	}
+	if l.URL != "" {
+		ol.LicenseCrossReferences = []string{l.URL}
+	}
+	c.doc.OtherLicenses = append(c.doc.OtherLicenses, ol)
+
+	return id
+}
@@ -0,0 +1,133 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package convert maps sboms between the SPDX 2.3 and CycloneDX models, so that
+// inputs of one spec can be assembled into an output of the other.
+//
+// The conversion is lossy, only component identity, licenses, suppliers, hashes
+// and the dependency graph are carried over. See the README for the list of
+// fields which are dropped.
+package convert
+
+import (
+	"regexp"
+	"strings"
+
+	cydx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+const (
+	NOASSERTION = "NOASSERTION"
+	NONE        = "NONE"
+)
+
+// Dropped counts, for each field, the values which could not be converted.
+type Dropped map[string]int
+
+func (d Dropped) add(field string, count int) {
+	if count > 0 {
+		d[field] += count
+	}
+}
+
+var cdxToSpdxHashAlgos = map[cydx.HashAlgorithm]common.ChecksumAlgorithm{
+	cydx.HashAlgoMD5:         common.MD5,
+	cydx.HashAlgoSHA1:        common.SHA1,
+	cydx.HashAlgoSHA256:      common.SHA256,
+	cydx.HashAlgoSHA384:      common.SHA384,
+	cydx.HashAlgoSHA512:      common.SHA512,
+	cydx.HashAlgoSHA3_256:    common.SHA3_256,
+	cydx.HashAlgoSHA3_384:    common.SHA3_384,
+	cydx.HashAlgoSHA3_512:    common.SHA3_512,
+	cydx.HashAlgoBlake2b_256: common.BLAKE2b_256,
+	cydx.HashAlgoBlake2b_384: common.BLAKE2b_384,
+	cydx.HashAlgoBlake2b_512: common.BLAKE2b_512,
+	cydx.HashAlgoBlake3:      common.BLAKE3,
+}
+
+var spdxToCdxHashAlgos = map[common.ChecksumAlgorithm]cydx.HashAlgorithm{
+	common.MD5:         cydx.HashAlgoMD5,
+	common.SHA1:        cydx.HashAlgoSHA1,
+	common.SHA256:      cydx.HashAlgoSHA256,
+	common.SHA384:      cydx.HashAlgoSHA384,
+	common.SHA512:      cydx.HashAlgoSHA512,
+	common.SHA3_256:    cydx.HashAlgoSHA3_256,
+	common.SHA3_384:    cydx.HashAlgoSHA3_384,
+	common.SHA3_512:    cydx.HashAlgoSHA3_512,
+	common.BLAKE2b_256: cydx.HashAlgoBlake2b_256,
+	common.BLAKE2b_384: cydx.HashAlgoBlake2b_384,
+	common.BLAKE2b_512: cydx.HashAlgoBlake2b_512,
+	common.BLAKE3:      cydx.HashAlgoBlake3,
+}
+
+var cdxToSpdxPurpose = map[cydx.ComponentType]string{
+	cydx.ComponentTypeApplication: "APPLICATION",
+	cydx.ComponentTypeFramework:   "FRAMEWORK",
+	cydx.ComponentTypeLibrary:     "LIBRARY",
+	cydx.ComponentTypeContainer:   "CONTAINER",
+	cydx.ComponentTypeOS:          "OPERATING-SYSTEM",
+	cydx.ComponentTypeDevice:      "DEVICE",
+	cydx.ComponentTypeFirmware:    "FIRMWARE",
+	cydx.ComponentTypeFile:        "FILE",
+}
+
+var spdxToCdxPurpose = map[string]cydx.ComponentType{
+	"APPLICATION":      cydx.ComponentTypeApplication,
+	"FRAMEWORK":        cydx.ComponentTypeFramework,
+	"LIBRARY":          cydx.ComponentTypeLibrary,
+	"CONTAINER":        cydx.ComponentTypeContainer,
+	"OPERATING-SYSTEM": cydx.ComponentTypeOS,
+	"DEVICE":           cydx.ComponentTypeDevice,
+	"FIRMWARE":         cydx.ComponentTypeFirmware,
+	"FILE":             cydx.ComponentTypeFile,
+}
+
+var invalidSpdxIDChars = regexp.MustCompile(`[^A-Za-z0-9.\-]+`)
+
+// sanitizeSpdxID replaces every character not allowed in an SPDX identifier
+// with a dash.
+func sanitizeSpdxID(id string) string {
+	id = invalidSpdxIDChars.ReplaceAllString(id, "-")
+	return strings.Trim(id, "-")
+}
+
+// isAbsent reports whether an SPDX value carries no information.
+func isAbsent(value string) bool {
+	v := strings.TrimSpace(value)
+	return v == "" || v == NOASSERTION || v == NONE
+}
+
+// splitNameEmail splits "Name (email)" as used by SPDX creators and suppliers.
+func splitNameEmail(value string) (string, string) {
+	value = strings.TrimSpace(value)
+	open := strings.LastIndex(value, "(")
+	if open == -1 || !strings.HasSuffix(value, ")") {
+		return value, ""
+	}
+	return strings.TrimSpace(value[:open]), strings.TrimSpace(value[open+1 : len(value)-1])
+}
+
+// joinNameEmail is the reverse of splitNameEmail.
+func joinNameEmail(name, email string) string {
+	if email == "" {
+		return name
+	}
+	if name == "" {
+		return email
+	}
+	return name + " (" + email + ")"
+}
@@ -0,0 +1,350 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"fmt"
+	"strings"
+
+	cydx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+	"github.com/spdx/tools-golang/spdx/v2/v2_3"
+)
+
+// SpdxToCdx converts an SPDX 2.3 document into a CycloneDX bom.
+//
+// The package described by the document becomes the metadata component, all
+// other packages become top level components. DEPENDS_ON and CONTAINS
+// relationships (and their inverses) become dependencies. The values which
+// have no CycloneDX equivalent are counted in the returned Dropped.
+func SpdxToCdx(doc *v2_3.Document) (*cydx.BOM, Dropped, error) {
+	if doc == nil {
+		return nil, nil, fmt.Errorf("spdx document is empty")
+	}
+
+	dropped := Dropped{}
+	dropped.add("files", len(doc.Files))
+	dropped.add("snippets", len(doc.Snippets))
+	dropped.add("annotations", len(doc.Annotations))
+	dropped.add("externalDocumentRefs", len(doc.ExternalDocumentReferences))
+
+	bom := cydx.NewBOM()
+	if doc.DocumentNamespace != "" {
+		bom.SerialNumber = fmt.Sprintf("urn:uuid:%s", uuid.NewSHA1(uuid.NameSpaceURL, []byte(doc.DocumentNamespace)))
+	}
+
+	bom.Metadata = spdxCreationInfoToMetadata(doc.CreationInfo)
+
+	otherLicenses := map[string]*v2_3.OtherLicense{}
+	for _, l := range doc.OtherLicenses {
+		if l != nil {
+			otherLicenses[l.LicenseIdentifier] = l
+		}
+	}
+	dropped.add("hasExtractedLicensingInfos.extractedText", len(otherLicenses))
+
+	described := describedPackages(doc)
+
+	components := []cydx.Component{}
+	for _, pkg := range doc.Packages {
+		if pkg == nil {
+			continue
+		}
+
+		comp := spdxPackageToComponent(pkg, otherLicenses, dropped)
+		if len(described) > 0 && pkg.PackageSPDXIdentifier == described[0] && bom.Metadata.Component == nil {
+			bom.Metadata.Component = &comp
+			continue
+		}
+		components = append(components, comp)
+	}
+	bom.Components = &components
+
+	deps := spdxRelationshipsToDependencies(doc, dropped)
+	bom.Dependencies = &deps
+
+	return bom, dropped, nil
+}
+
+func spdxCreationInfoToMetadata(ci *v2_3.CreationInfo) *cydx.Metadata {
+	md := &cydx.Metadata{}
+	if ci == nil {
+		return md
+	}
+
+	md.Timestamp = ci.Created
+
+	tools := []cydx.Component{}
+	authors := []cydx.OrganizationalContact{}
+
+	for _, c := range ci.Creators {
+		switch c.CreatorType {
+		case "Tool":
+			name, version := splitToolNameVersion(c.Creator)
+			tools = append(tools, cydx.Component{
+				Type:    cydx.ComponentTypeApplication,
+				Name:    name,
+				Version: version,
+			})
+		case "Person":
+			name, email := splitNameEmail(c.Creator)
+			authors = append(authors, cydx.OrganizationalContact{Name: name, Email: email})
+		case "Organization":
+			if md.Supplier == nil {
+				name, email := splitNameEmail(c.Creator)
+				md.Supplier = &cydx.OrganizationalEntity{Name: name}
+				if email != "" {
+					md.Supplier.Contact = &[]cydx.OrganizationalContact{{Email: email}}
+				}
+			}
+		}
+	}
+
+	if len(tools) > 0 {
+		md.Tools = &cydx.ToolsChoice{Components: &tools}
+	}
+
+	if len(authors) > 0 {
+		md.Authors = &authors
+	}
+
+	return md
+}
+
+// splitToolNameVersion splits an SPDX tool creator of the form "name-version".
+func splitToolNameVersion(tool string) (string, string) {
+	idx := strings.LastIndex(tool, "-")
+	if idx <= 0 || idx == len(tool)-1 {
+		return tool, ""
+	}
+	return tool[:idx], tool[idx+1:]
+}
+
+// describedPackages returns the ids of the packages described by the document,
+// in the order they are listed.
+func describedPackages(doc *v2_3.Document) []common.ElementID {
+	ids := []common.ElementID{}
+	for _, r := range doc.Relationships {
+		if r == nil {
+			continue
+		}
+		switch strings.ToUpper(r.Relationship) {
+		case common.TypeRelationshipDescribe:
+			if r.RefA.ElementRefID == "DOCUMENT" && r.RefB.DocumentRefID == "" && r.RefB.ElementRefID != "" {
+				ids = append(ids, r.RefB.ElementRefID)
+			}
+		case common.TypeRelationshipDescribeBy:
+			if r.RefB.ElementRefID == "DOCUMENT" && r.RefA.DocumentRefID == "" && r.RefA.ElementRefID != "" {
+				ids = append(ids, r.RefA.ElementRefID)
+			}
+		}
+	}
+	return lo.Uniq(ids)
+}
+
+func spdxBomRef(id common.ElementID) string {
+	return fmt.Sprintf("SPDXRef-%s", id)
+}
+
+func spdxPackageToComponent(pkg *v2_3.Package, otherLicenses map[string]*v2_3.OtherLicense, dropped Dropped) cydx.Component {
+	comp := cydx.Component{
+		BOMRef:      spdxBomRef(pkg.PackageSPDXIdentifier),
+		Type:        cydx.ComponentTypeLibrary,
+		Name:        pkg.PackageName,
+		Version:     pkg.PackageVersion,
+		Description: pkg.PackageDescription,
+	}
+
+	if t, ok := spdxToCdxPurpose[strings.ToUpper(pkg.PrimaryPackagePurpose)]; ok {
+		comp.Type = t
+	}
+
+	if comp.Description == "" {
+		comp.Description = pkg.PackageSummary
+	}
+
+	dropped.add("packages.files", len(pkg.Files))
+	dropped.add("packages.annotations", len(pkg.Annotations))
+	if pkg.PackageVerificationCode != nil {
+		dropped.add("packages.packageVerificationCode", 1)
+	}
+	if pkg.PackageComment != "" {
+		dropped.add("packages.comment", 1)
+	}
+
+	if pkg.PackageSupplier != nil && !isAbsent(pkg.PackageSupplier.Supplier) {
+		name, email := splitNameEmail(pkg.PackageSupplier.Supplier)
+		comp.Supplier = &cydx.OrganizationalEntity{Name: name}
+		if email != "" {
+			comp.Supplier.Contact = &[]cydx.OrganizationalContact{{Email: email}}
+		}
+	}
+
+	if pkg.PackageOriginator != nil && !isAbsent(pkg.PackageOriginator.Originator) {
+		comp.Author = pkg.PackageOriginator.Originator
+	}
+
+	if !isAbsent(pkg.PackageCopyrightText) {
+		comp.Copyright = pkg.PackageCopyrightText
+	}
+
+	hashes := []cydx.Hash{}
+	for _, c := range pkg.PackageChecksums {
+		if alg, ok := spdxToCdxHashAlgos[c.Algorithm]; ok {
+			hashes = append(hashes, cydx.Hash{Algorithm: alg, Value: c.Value})
+		} else {
+			dropped.add("packages.checksums", 1)
+		}
+	}
+	if len(hashes) > 0 {
+		comp.Hashes = &hashes
+	}
+
+	license := pkg.PackageLicenseDeclared
+	if isAbsent(license) {
+		license = pkg.PackageLicenseConcluded
+	}
+	if !isAbsent(license) {
+		comp.Licenses = spdxLicenseToCdx(license, otherLicenses)
+	}
+
+	purls := []string{}
+	cpes := []string{}
+	for _, ref := range pkg.PackageExternalReferences {
+		if ref == nil {
+			continue
+		}
+		switch {
+		case strings.EqualFold(ref.RefType, common.TypePackageManagerPURL):
+			purls = append(purls, ref.Locator)
+		case strings.EqualFold(ref.RefType, common.TypeSecurityCPE23Type):
+			// cpe 2.3 is preferred over 2.2
+			cpes = append([]string{ref.Locator}, cpes...)
+		case strings.EqualFold(ref.RefType, common.TypeSecurityCPE22Type):
+			cpes = append(cpes, ref.Locator)
+		default:
+			dropped.add("packages.externalRefs", 1)
+		}
+	}
+	if len(purls) > 0 {
+		comp.PackageURL = purls[0]
+		dropped.add("packages.externalRefs", len(purls)-1)
+	}
+	if len(cpes) > 0 {
+		comp.CPE = cpes[0]
+		dropped.add("packages.externalRefs", len(cpes)-1)
+	}
+
+	extRefs := []cydx.ExternalReference{}
+	if !isAbsent(pkg.PackageDownloadLocation) {
+		extRefs = append(extRefs, cydx.ExternalReference{
+			Type: cydx.ERTypeDistribution,
+			URL:  pkg.PackageDownloadLocation,
+		})
+	}
+	if !isAbsent(pkg.PackageHomePage) {
+		extRefs = append(extRefs, cydx.ExternalReference{
+			Type: cydx.ERTypeWebsite,
+			URL:  pkg.PackageHomePage,
+		})
+	}
+	if len(extRefs) > 0 {
+		comp.ExternalReferences = &extRefs
+	}
+
+	return comp
+}
+
+// spdxLicenseToCdx converts an SPDX license expression. A single license id is
+// kept as a license, LicenseRef- ids are resolved to their name, anything else is
+// kept as an expression.
+func spdxLicenseToCdx(expression string, otherLicenses map[string]*v2_3.OtherLicense) *cydx.Licenses {
+	expression = strings.TrimSpace(expression)
+
+	if strings.ContainsAny(expression, " ()") {
+		return &cydx.Licenses{{Expression: expression}}
+	}
+
+	if strings.HasPrefix(expression, "LicenseRef-") || strings.HasPrefix(expression, "DocumentRef-") {
+		name := expression
+		if ol, ok := otherLicenses[expression]; ok && ol.LicenseName != "" && !isAbsent(ol.LicenseName) {
+			name = ol.LicenseName
+		}
+		return &cydx.Licenses{{License: &cydx.License{Name: name}}}
+	}
+
+	return &cydx.Licenses{{License: &cydx.License{ID: expression}}}
+}
+
+// spdxRelationshipsToDependencies builds the dependency graph from the package
+// relationships of the document. Relationships to files, external documents and
+// other relationship types are dropped.
+func spdxRelationshipsToDependencies(doc *v2_3.Document, dropped Dropped) []cydx.Dependency {
+	pkgIDs := map[common.ElementID]bool{}
+	for _, pkg := range doc.Packages {
+		if pkg != nil {
+			pkgIDs[pkg.PackageSPDXIdentifier] = true
+		}
+	}
+
+	order := []common.ElementID{}
+	edges := map[common.ElementID][]common.ElementID{}
+
+	addEdge := func(from, to common.DocElementID) {
+		if from.DocumentRefID != "" || to.DocumentRefID != "" {
+			dropped.add("relationships", 1)
+			return
+		}
+		if !pkgIDs[from.ElementRefID] || !pkgIDs[to.ElementRefID] || from.ElementRefID == to.ElementRefID {
+			dropped.add("relationships", 1)
+			return
+		}
+		if _, ok := edges[from.ElementRefID]; !ok {
+			order = append(order, from.ElementRefID)
+		}
+		if !lo.Contains(edges[from.ElementRefID], to.ElementRefID) {
+			edges[from.ElementRefID] = append(edges[from.ElementRefID], to.ElementRefID)
+		}
+	}
+
+	for _, r := range doc.Relationships {
+		if r == nil {
+			continue
+		}
+		switch strings.ToUpper(r.Relationship) {
+		case common.TypeRelationshipDependsOn, common.TypeRelationshipContains:
+			addEdge(r.RefA, r.RefB)
+		case common.TypeRelationshipDependencyOf, common.TypeRelationshipContainedBy:
+			addEdge(r.RefB, r.RefA)
+		case common.TypeRelationshipDescribe, common.TypeRelationshipDescribeBy:
+		default:
+			dropped.add("relationships", 1)
+		}
+	}
+
+	return lo.Map(order, func(id common.ElementID, _ int) cydx.Dependency {
+		deps := lo.Map(edges[id], func(d common.ElementID, _ int) string {
+			return spdxBomRef(d)
+		})
+		return cydx.Dependency{
+			Ref:          spdxBomRef(id),
+			Dependencies: &deps,
+		}
+	})
+}
@@ -0,0 +1,168 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dt
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"syscall"
+	"time"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/interlynk-io/sbomasm/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	// DEFAULT_TIMEOUT is the timeout of each attempt of an api call
+	DEFAULT_TIMEOUT = 10 * time.Second
+	// DEFAULT_RETRIES is how often a failed api call is retried
+	DEFAULT_RETRIES = 3
+)
+
+// retryBaseDelay is the delay before the first retry, it doubles with every
+// following retry.
+const retryBaseDelay = 500 * time.Millisecond
+
+func (dtP *Params) newClient(ctx context.Context) (*dtrack.Client, error) {
+	timeout := dtP.Timeout
+	if timeout <= 0 {
+		timeout = DEFAULT_TIMEOUT
+	}
+
+	httpClient := &http.Client{
+		Transport: &retryTransport{
+			base:    http.DefaultTransport,
+			timeout: timeout,
+			retries: max(dtP.Retries, 0),
+			log:     logger.FromContext(ctx),
+		},
+	}
+
+	// The api key option wraps the transport, so the http client goes first
+	return dtrack.NewClient(dtP.Url, dtrack.WithHttpClient(httpClient),
+		dtrack.WithAPIKey(dtP.ApiKey), dtrack.WithDebug(false))
+}
+
+// retryTransport retries requests which failed with a 5xx status or a
+// connection error, backing off exponentially. Every attempt gets its own
+// timeout, the request context cancels all of them.
+type retryTransport struct {
+	base    http.RoundTripper
+	timeout time.Duration
+	retries int
+	log     *zap.SugaredLogger
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	// The body is read by every attempt, so it has to be replayable
+	if req.Body != nil && req.GetBody == nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		req = req.Clone(ctx)
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		res, err := t.roundTrip(req)
+		if attempt >= t.retries || ctx.Err() != nil || !retryable(res, err) {
+			return res, err
+		}
+
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = res.Status
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		delay := retryBaseDelay << attempt
+		t.log.Debugf("retrying %s %s in %s, attempt %d of %d failed: %s", req.Method, req.URL.Path, delay, attempt+1, t.retries+1, reason)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (t *retryTransport) roundTrip(req *http.Request) (*http.Response, error) {
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+
+	attempt := req.Clone(ctx)
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		attempt.Body = body
+	}
+
+	res, err := t.base.RoundTrip(attempt)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	// The timeout has to outlive the response until its body is read
+	res.Body = &cancelBody{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+// retryable reports whether a failed request might succeed when sent again.
+// Authentication and other client errors are never retried.
+func retryable(res *http.Response, err error) bool {
+	if err != nil {
+		return errors.Is(err, syscall.ECONNRESET) ||
+			errors.Is(err, syscall.ECONNREFUSED) ||
+			errors.Is(err, io.ErrUnexpectedEOF) ||
+			errors.Is(err, io.EOF) ||
+			errors.Is(err, context.DeadlineExceeded)
+	}
+
+	switch res.StatusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
@@ -2,19 +2,46 @@ package dt
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/Masterminds/semver/v3"
 	"github.com/google/uuid"
 	"github.com/interlynk-io/sbomasm/pkg/logger"
+	"github.com/interlynk-io/sbomasm/pkg/progress"
+	"github.com/samber/lo"
 )
 
+const (
+	// ENV_URL and ENV_API_KEY are read when the url and api key flags are
+	// omitted, so that the key does not end up in the shell history
+	ENV_URL     = "SBOMASM_DT_URL"
+	ENV_API_KEY = "SBOMASM_DT_API_KEY"
+)
+
+// redacted replaces the api key wherever it would be printed
+const redacted = "[REDACTED]"
+
 type Params struct {
 	Url             string
 	ApiKey          string
 	ProjectIds      []uuid.UUID
+	ProjectRefs     []ProjectRef
+	Tags            []string
+	LatestOnly      bool
 	UploadProjectID uuid.UUID
+	UploadName      string
+	UploadVersion   string
+	Wait            bool
+
+	// Timeout is the timeout of each attempt of an api call, Retries is how
+	// often a call failing with a transient error is retried
+	Timeout time.Duration
+	Retries int
 
 	Ctx    *context.Context
 	Input  []string
@@ -32,48 +59,217 @@ type Params struct {
 	Xml  bool
 	Json bool
 
+	// SpdxFormat is the file format of spdx output, json or tagvalue
+	SpdxFormat string
+
 	OutputSpec        string
 	OutputSpecVersion string
 }
 
+// ProjectRef identifies a project by its name and version.
+type ProjectRef struct {
+	Name    string
+	Version string
+}
+
+func (r ProjectRef) String() string {
+	return r.Name + ":" + r.Version
+}
+
 func NewParams() *Params {
 	return &Params{}
 }
 
-func (dtP *Params) PopulateInputField(ctx context.Context) {
+// redacted returns a copy of the params without the api key, to be logged.
+func (dtP *Params) redacted() Params {
+	p := *dtP
+	if p.ApiKey != "" {
+		p.ApiKey = redacted
+	}
+	return p
+}
+
+// RedactError hides the api key in the message of err, for errors which echo
+// a request.
+func (dtP *Params) RedactError(err error) error {
+	if err == nil || dtP.ApiKey == "" || !strings.Contains(err.Error(), dtP.ApiKey) {
+		return err
+	}
+	return errors.New(strings.ReplaceAll(err.Error(), dtP.ApiKey, redacted))
+}
+
+func (dtP *Params) PopulateInputField(ctx context.Context) error {
 	log := logger.FromContext(ctx)
 
-	log.Debugf("Config: %+v", dtP)
+	log.Debugf("Config: %+v", dtP.redacted())
 
-	dTrackClient, err := dtrack.NewClient(dtP.Url,
-		dtrack.WithAPIKey(dtP.ApiKey), dtrack.WithDebug(false))
+	dTrackClient, err := dtP.newClient(ctx)
 	if err != nil {
-		log.Fatalf("Failed to create Dependency-Track client: %s", err)
+		return fmt.Errorf("failed to create Dependency-Track client: %w", err)
 	}
 
+	for _, ref := range dtP.ProjectRefs {
+		pid, err := resolveProject(ctx, dTrackClient, ref)
+		if err != nil {
+			return err
+		}
+		log.Debugf("Resolved project %s to %s", ref, pid)
+		dtP.ProjectIds = append(dtP.ProjectIds, pid)
+	}
+
+	if len(dtP.Tags) > 0 {
+		pids, err := projectsForTags(ctx, dTrackClient, dtP.Tags, dtP.LatestOnly)
+		if err != nil {
+			return err
+		}
+		dtP.ProjectIds = lo.Uniq(append(dtP.ProjectIds, pids...))
+	}
+
+	p := progress.FromContext(ctx)
+	p.Start("fetching projects", len(dtP.ProjectIds))
+	defer p.Done()
+
 	for _, pid := range dtP.ProjectIds {
 		log.Debugf("Processing project %s", pid)
 
 		prj, err := dTrackClient.Project.Get(ctx, pid)
 		if err != nil {
 			log.Infof("Failed to get project, Check projectID or API port or Hostname.")
-			log.Fatalf("Failed to get project: %s", err)
+			return requestError(dtP.Url, fmt.Sprintf("failed to get project %s", pid), "VIEW_PORTFOLIO", err)
 		}
 		log.Debugf("ID: %s, Name: %s, Version: %s", prj.UUID, prj.Name, prj.Version)
 
 		bom, err := dTrackClient.BOM.ExportProject(ctx, pid, dtrack.BOMFormatJSON, dtrack.BOMVariantInventory)
 		if err != nil {
-			log.Fatalln("Failed to export project: %s", err)
+			return requestError(dtP.Url, fmt.Sprintf("failed to export project %s", pid), "VIEW_PORTFOLIO", err)
 		}
 
 		fname := fmt.Sprintf("tmpfile-%s", pid)
 		f, err := os.CreateTemp("", fname)
 		if err != nil {
-			log.Fatal(err)
+			return err
 		}
-		defer f.Close()
 
-		f.WriteString(bom)
+		_, err = f.WriteString(bom)
+		f.Close()
+		if err != nil {
+			return err
+		}
 		dtP.Input = append(dtP.Input, f.Name())
+		p.Step(fmt.Sprintf("%s@%s", prj.Name, prj.Version))
+	}
+	return nil
+}
+
+// ParseProjectRef parses a "name:version" project specifier. The version is
+// taken from the last colon, so names containing colons are supported.
+func ParseProjectRef(spec string) (ProjectRef, bool) {
+	i := strings.LastIndex(spec, ":")
+	if i <= 0 || i == len(spec)-1 {
+		return ProjectRef{}, false
+	}
+	return ProjectRef{Name: spec[:i], Version: spec[i+1:]}, true
+}
+
+// resolveProject looks up the id of the project with the exact name and
+// version of ref.
+func resolveProject(ctx context.Context, client *dtrack.Client, ref ProjectRef) (uuid.UUID, error) {
+	prjs, err := client.Project.GetProjectsForName(ctx, ref.Name, false, false)
+	if err != nil {
+		msg := fmt.Sprintf("failed to look up project %s", ref)
+		return uuid.Nil, requestError(client.BaseURL().String(), msg, "VIEW_PORTFOLIO", err)
+	}
+
+	matches := lo.Filter(prjs, func(p dtrack.Project, _ int) bool {
+		return p.Name == ref.Name && p.Version == ref.Version
+	})
+
+	switch len(matches) {
+	case 0:
+		return uuid.Nil, fmt.Errorf("project %s not found, check the name and version or the api key permissions", ref)
+	case 1:
+		return matches[0].UUID, nil
+	default:
+		ids := lo.Map(matches, func(p dtrack.Project, _ int) string {
+			return p.UUID.String()
+		})
+		return uuid.Nil, fmt.Errorf("project %s is ambiguous, it matches %s, use a project id instead", ref, strings.Join(ids, ", "))
+	}
+}
+
+// projectsForTags returns the ids of the projects carrying any of the tags.
+// With latestOnly only the most recent version of each project name is kept.
+func projectsForTags(ctx context.Context, client *dtrack.Client, tags []string, latestOnly bool) ([]uuid.UUID, error) {
+	log := logger.FromContext(ctx)
+
+	var prjs []dtrack.Project
+	for _, tag := range tags {
+		tagged, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
+			return client.Project.GetAllByTag(ctx, tag, true, false, po)
+		})
+		if err != nil {
+			msg := fmt.Sprintf("failed to list projects with tag %s", tag)
+			return nil, requestError(client.BaseURL().String(), msg, "VIEW_PORTFOLIO", err)
+		}
+
+		if len(tagged) == 0 {
+			log.Warnf("no projects found with tag %s", tag)
+		}
+		log.Debugf("Found %d projects with tag %s", len(tagged), tag)
+		prjs = append(prjs, tagged...)
+	}
+
+	prjs = lo.UniqBy(prjs, func(p dtrack.Project) uuid.UUID {
+		return p.UUID
+	})
+
+	if len(prjs) == 0 {
+		return nil, fmt.Errorf("no projects found with tags %s", strings.Join(tags, ", "))
+	}
+
+	if latestOnly {
+		prjs = latestVersions(prjs)
+	}
+
+	return lo.Map(prjs, func(p dtrack.Project, _ int) uuid.UUID {
+		return p.UUID
+	}), nil
+}
+
+// latestVersions keeps the latest version of each project name. Versions are
+// compared as semver when all versions of a name parse, otherwise the project
+// with the most recent bom import wins.
+func latestVersions(prjs []dtrack.Project) []dtrack.Project {
+	byName := lo.GroupBy(prjs, func(p dtrack.Project) string {
+		return p.Name
+	})
+
+	latest := []dtrack.Project{}
+	for _, p := range prjs {
+		versions, ok := byName[p.Name]
+		if !ok {
+			continue
+		}
+		delete(byName, p.Name)
+
+		latest = append(latest, lo.MaxBy(versions, newerProject(versions)))
+	}
+	return latest
+}
+
+func newerProject(versions []dtrack.Project) func(a, b dtrack.Project) bool {
+	semvers := map[uuid.UUID]*semver.Version{}
+	for _, p := range versions {
+		v, err := semver.NewVersion(p.Version)
+		if err != nil {
+			return func(a, b dtrack.Project) bool {
+				return a.LastBOMImport > b.LastBOMImport
+			}
+		}
+		semvers[p.UUID] = v
+	}
+
+	return func(a, b dtrack.Project) bool {
+		return semvers[a.UUID].GreaterThan(semvers[b.UUID])
 	}
 }
@@ -0,0 +1,147 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dt
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/interlynk-io/sbomasm/pkg/logger"
+)
+
+// uploadPollInterval is how often the processing of an upload is checked.
+const uploadPollInterval = 2 * time.Second
+
+// UploadBom uploads the assembled sbom to the project set by UploadProjectID,
+// or by UploadName and UploadVersion in which case the project is created when
+// it does not exist. With Wait it returns once Dependency-Track has processed
+// the sbom.
+func (dtP *Params) UploadBom(ctx context.Context, bom []byte) error {
+	log := logger.FromContext(ctx)
+
+	dTrackClient, err := dtP.newClient(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create Dependency-Track client: %w", err)
+	}
+
+	req := dtrack.BOMUploadRequest{
+		BOM: base64.StdEncoding.EncodeToString(bom),
+	}
+
+	target := ProjectRef{Name: dtP.UploadName, Version: dtP.UploadVersion}.String()
+	if dtP.UploadProjectID != uuid.Nil {
+		req.ProjectUUID = &dtP.UploadProjectID
+		target = dtP.UploadProjectID.String()
+	} else {
+		req.ProjectName = dtP.UploadName
+		req.ProjectVersion = dtP.UploadVersion
+		req.AutoCreate = true
+	}
+
+	log.Debugf("uploading sbom to project %s at %s", target, dtP.Url)
+
+	token, err := dTrackClient.BOM.Upload(ctx, req)
+	if err != nil {
+		return uploadError(dtP.Url, target, err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Uploaded sbom to project %s, upload token: %s\n", target, token)
+
+	if !dtP.Wait {
+		return nil
+	}
+
+	if err := waitForProcessing(ctx, dTrackClient, token); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Dependency-Track finished processing upload %s\n", token)
+	return nil
+}
+
+func waitForProcessing(ctx context.Context, client *dtrack.Client, token dtrack.BOMUploadToken) error {
+	log := logger.FromContext(ctx)
+
+	ticker := time.NewTicker(uploadPollInterval)
+	defer ticker.Stop()
+
+	for {
+		processing, err := client.BOM.IsBeingProcessed(ctx, token)
+		if err != nil {
+			return fmt.Errorf("failed to check the processing of upload %s: %w", token, err)
+		}
+
+		if !processing {
+			return nil
+		}
+		log.Debugf("upload %s is being processed", token)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// uploadError explains the common causes of a failed upload.
+func uploadError(baseURL, target string, err error) error {
+	var apiErr *dtrack.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("failed to upload sbom, project %s not found: %w", target, err)
+	}
+
+	msg := fmt.Sprintf("failed to upload sbom to project %s", target)
+	return requestError(baseURL, msg, "BOM_UPLOAD, and PROJECT_CREATION_UPLOAD to create projects,", err)
+}
+
+// requestError explains a failed Dependency-Track request which requires the
+// given permission.
+func requestError(baseURL, msg, permission string, err error) error {
+	var apiErr *dtrack.APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusUnauthorized:
+			return fmt.Errorf("%s, the api key was rejected: %w", msg, err)
+		case http.StatusForbidden:
+			return fmt.Errorf("%s, the api key requires the %s permission: %w", msg, permission, err)
+		}
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return fmt.Errorf("%s, interrupted: %w", msg, err)
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("%s, the request to Dependency-Track at %s timed out: %w", msg, baseURL, err)
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return fmt.Errorf("failed to reach Dependency-Track at %s, check the url: %w", baseURL, err)
+	}
+
+	return fmt.Errorf("%s: %w", msg, err)
+}
@@ -3,7 +3,6 @@ package licenses
 import (
 	"embed"
 	"encoding/json"
-	"fmt"
 	"log"
 	"strings"
 )
@@ -59,13 +58,11 @@ var (
 func loadSpdxLicense() error {
 	licData, err := res.ReadFile(licenses["spdx"])
 	if err != nil {
-		fmt.Printf("error: %v\n", err)
 		return err
 	}
 
 	var sl spdxLicense
 	if err := json.Unmarshal(licData, &sl); err != nil {
-		fmt.Printf("error: %v\n", err)
 		return err
 	}
 
@@ -82,20 +79,17 @@ func loadSpdxLicense() error {
 			source:      "spdx",
 		}
 	}
-	// fmt.Printf("loaded %d licenses\n", len(licenseList))
 	return nil
 }
 
 func loadSpdxExceptions() error {
 	licData, err := res.ReadFile(licenses["spdxException"])
 	if err != nil {
-		fmt.Printf("error: %v\n", err)
 		return err
 	}
 
 	var sl spdxLicense
 	if err := json.Unmarshal(licData, &sl); err != nil {
-		fmt.Printf("error: %v\n", err)
 		return err
 	}
 
@@ -112,7 +106,6 @@ func loadSpdxExceptions() error {
 			source:      "spdx",
 		}
 	}
-	// fmt.Printf("loaded %d licenses\n", len(licenseList))
 
 	return nil
 }
@@ -120,14 +113,12 @@ func loadSpdxExceptions() error {
 func loadAboutCodeLicense() error {
 	licData, err := res.ReadFile(licenses["aboutcode"])
 	if err != nil {
-		fmt.Printf("error: %v\n", err)
 		return err
 	}
 
 	var acl []aboutCodeLicenseDetail
 
 	if err := json.Unmarshal(licData, &acl); err != nil {
-		fmt.Printf("error: %v\n", err)
 		return err
 	}
 
@@ -177,7 +168,6 @@ func loadAboutCodeLicense() error {
 			source:      "aboutcode",
 		}
 	}
-	// fmt.Printf("loaded %d licenses\n", len(LicenseListAboutCode))
 
 	return nil
 }
@@ -0,0 +1,275 @@
+// Copyright 2023 Interlynk.io
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package licenses
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// normalizeIndex maps lower cased license ids, names, aliases and aboutcode
+// keys to SPDX license ids
+type normalizeIndex struct {
+	ids       map[string]string
+	names     map[string]string
+	aliases   map[string]string
+	aboutCode map[string]string
+}
+
+// licenseAliases are the short names licenses commonly go by, which are
+// neither an SPDX id nor close enough to an SPDX name. They are matched by
+// their nameKey, so "Apache 2" also matches "apache, version 2".
+var licenseAliases = map[string]string{
+	"Apache 2":                           "Apache-2.0",
+	"Apache 2.0":                         "Apache-2.0",
+	"Apache License 2":                   "Apache-2.0",
+	"Apache Software License 2.0":        "Apache-2.0",
+	"ASL 2.0":                            "Apache-2.0",
+	"BSD 2-Clause License":               "BSD-2-Clause",
+	"BSD 2-Clause":                       "BSD-2-Clause",
+	"2-Clause BSD License":               "BSD-2-Clause",
+	"Simplified BSD License":             "BSD-2-Clause",
+	"FreeBSD License":                    "BSD-2-Clause",
+	"BSD 3-Clause License":               "BSD-3-Clause",
+	"BSD 3-Clause":                       "BSD-3-Clause",
+	"3-Clause BSD License":               "BSD-3-Clause",
+	"New BSD License":                    "BSD-3-Clause",
+	"Modified BSD License":               "BSD-3-Clause",
+	"Revised BSD License":                "BSD-3-Clause",
+	"MIT License":                        "MIT",
+	"The MIT License (MIT)":              "MIT",
+	"Expat License":                      "MIT",
+	"ISC License":                        "ISC",
+	"GPLv2":                              "GPL-2.0-only",
+	"GPL 2":                              "GPL-2.0-only",
+	"GPL 2.0":                            "GPL-2.0-only",
+	"GNU GPL 2":                          "GPL-2.0-only",
+	"GPLv2+":                             "GPL-2.0-or-later",
+	"GPL 2+":                             "GPL-2.0-or-later",
+	"GPLv3":                              "GPL-3.0-only",
+	"GPL 3":                              "GPL-3.0-only",
+	"GPL 3.0":                            "GPL-3.0-only",
+	"GNU GPL 3":                          "GPL-3.0-only",
+	"GPLv3+":                             "GPL-3.0-or-later",
+	"GPL 3+":                             "GPL-3.0-or-later",
+	"LGPLv2.1":                           "LGPL-2.1-only",
+	"LGPL 2.1":                           "LGPL-2.1-only",
+	"LGPLv2.1+":                          "LGPL-2.1-or-later",
+	"LGPLv3":                             "LGPL-3.0-only",
+	"LGPL 3":                             "LGPL-3.0-only",
+	"LGPLv3+":                            "LGPL-3.0-or-later",
+	"AGPLv3":                             "AGPL-3.0-only",
+	"AGPL 3":                             "AGPL-3.0-only",
+	"AGPLv3+":                            "AGPL-3.0-or-later",
+	"MPL 2":                              "MPL-2.0",
+	"MPL 2.0":                            "MPL-2.0",
+	"MPLv2":                              "MPL-2.0",
+	"EPL 1.0":                            "EPL-1.0",
+	"EPL 2.0":                            "EPL-2.0",
+	"CC0":                                "CC0-1.0",
+	"Public Domain (CC0)":                "CC0-1.0",
+	"Boost Software License":             "BSL-1.0",
+	"zlib License":                       "Zlib",
+	"Python Software Foundation License": "PSF-2.0",
+}
+
+var (
+	indexOnce sync.Once
+	index     normalizeIndex
+)
+
+// nameKey reduces a license name to the words which identify it, so that
+// "The Apache License, Version 2.0" matches "Apache License 2.0".
+func nameKey(name string) string {
+	name = strings.ToLower(strings.NewReplacer(",", " ", "\"", " ").Replace(name))
+	words := []string{}
+	for _, w := range strings.Fields(name) {
+		if w == "the" || w == "version" {
+			continue
+		}
+		if len(w) > 1 && w[0] == 'v' && w[1] >= '0' && w[1] <= '9' {
+			w = w[1:]
+		}
+		words = append(words, w)
+	}
+	return strings.Join(words, " ")
+}
+
+func buildIndex() {
+	index = normalizeIndex{
+		ids:       map[string]string{},
+		names:     map[string]string{},
+		aliases:   map[string]string{},
+		aboutCode: map[string]string{},
+	}
+
+	// prefer ids which are not deprecated, then the smallest id, so that the
+	// mapping does not depend on map order
+	better := func(id, current string) bool {
+		if current == "" {
+			return true
+		}
+		if licenseList[id].deprecated != licenseList[current].deprecated {
+			return licenseList[current].deprecated
+		}
+		return id < current
+	}
+
+	ids := make([]string, 0, len(licenseList))
+	for id := range licenseList {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		index.ids[strings.ToLower(id)] = id
+
+		key := nameKey(licenseList[id].name)
+		if better(id, index.names[key]) {
+			index.names[key] = id
+		}
+	}
+
+	for alias, id := range licenseAliases {
+		index.aliases[nameKey(alias)] = id
+	}
+
+	for _, l := range LicenseListAboutCode {
+		if _, ok := licenseList[l.short]; !ok {
+			continue
+		}
+		key := strings.ToLower(l.name)
+		if better(l.short, index.aboutCode[key]) {
+			index.aboutCode[key] = l.short
+		}
+	}
+}
+
+// normalizeID maps a single license id or name to an SPDX license id, keeping
+// a trailing "+".
+func normalizeID(value string) (string, bool) {
+	indexOnce.Do(buildIndex)
+
+	key := strings.TrimSpace(value)
+	// aliases such as GPLv2+ name the later versions themselves
+	if id, ok := index.aliases[nameKey(key)]; ok && strings.HasSuffix(key, "+") {
+		return id, true
+	}
+
+	plus := ""
+	if len(key) > 1 && strings.HasSuffix(key, "+") {
+		key, plus = strings.TrimSuffix(key, "+"), "+"
+	}
+	lower := strings.ToLower(key)
+
+	if id, ok := index.ids[lower]; ok {
+		return id + plus, true
+	}
+	if ref := strings.TrimPrefix(lower, "licenseref-"); ref != lower {
+		if id, ok := index.ids[ref]; ok {
+			return id + plus, true
+		}
+	}
+	if id, ok := index.names[nameKey(key)]; ok {
+		return id + plus, true
+	}
+	if id, ok := index.aliases[nameKey(key)]; ok {
+		return id + plus, true
+	}
+	if id, ok := index.aboutCode[lower]; ok {
+		return id + plus, true
+	}
+	return value, false
+}
+
+// tokenizeExpression splits a license expression into parentheses and words
+func tokenizeExpression(expression string) []string {
+	tokens := []string{}
+	for _, field := range strings.Fields(expression) {
+		for field != "" {
+			i := strings.IndexAny(field, "()")
+			switch {
+			case i == -1:
+				tokens = append(tokens, field)
+				field = ""
+			case i > 0:
+				tokens = append(tokens, field[:i])
+				field = field[i:]
+			default:
+				tokens = append(tokens, field[:1])
+				field = field[1:]
+			}
+		}
+	}
+	return tokens
+}
+
+// Normalize maps a license id, name or expression to SPDX license ids. Ids
+// are matched case insensitively, names against the SPDX license list, the
+// common short names of licenses and the aboutcode license keys, and
+// LicenseRef-<id> against the SPDX id. Every license of an expression is
+// mapped and its operators are upper cased. It reports false when the value,
+// or a license of the expression, can not be mapped, the parts which can not
+// be mapped are returned as is.
+func Normalize(value string) (string, bool) {
+	v := strings.TrimSpace(value)
+	if v == "" {
+		return value, false
+	}
+
+	switch strings.ToUpper(v) {
+	case "NONE", "NOASSERTION":
+		return strings.ToUpper(v), true
+	}
+
+	if id, ok := normalizeID(v); ok {
+		return id, true
+	}
+
+	tokens := tokenizeExpression(v)
+	operators := 0
+	for _, t := range tokens {
+		switch strings.ToUpper(t) {
+		case "AND", "OR", "WITH":
+			operators++
+		}
+	}
+	if operators == 0 {
+		return value, false
+	}
+
+	mapped := true
+	var sb strings.Builder
+	for i, t := range tokens {
+		if i > 0 && t != ")" && tokens[i-1] != "(" {
+			sb.WriteString(" ")
+		}
+
+		switch up := strings.ToUpper(t); up {
+		case "(", ")":
+			sb.WriteString(t)
+		case "AND", "OR", "WITH":
+			sb.WriteString(up)
+		default:
+			id, ok := normalizeID(t)
+			if !ok {
+				mapped = false
+			}
+			sb.WriteString(id)
+		}
+	}
+	return sb.String(), mapped
+}
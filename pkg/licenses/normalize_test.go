@@ -0,0 +1,106 @@
+// Copyright 2023 Interlynk.io
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package licenses
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   string
+		mapped bool
+	}{
+		// ids, names and refs
+		{"mit", "MIT", true},
+		{"apache-2.0", "Apache-2.0", true},
+		{"The Apache License, Version 2.0", "Apache-2.0", true},
+		{`BSD 3-Clause "New" or "Revised" License`, "BSD-3-Clause", true},
+		{"LicenseRef-MIT", "MIT", true},
+		{"GPL-2.0-or-later", "GPL-2.0-or-later", true},
+		{"noassertion", "NOASSERTION", true},
+
+		// aliases
+		{"MIT License", "MIT", true},
+		{"The MIT License (MIT)", "MIT", true},
+		{"BSD 3-Clause License", "BSD-3-Clause", true},
+		{"New BSD License", "BSD-3-Clause", true},
+		{"BSD 2-Clause License", "BSD-2-Clause", true},
+		{"Simplified BSD License", "BSD-2-Clause", true},
+		{"Apache 2", "Apache-2.0", true},
+		{"Apache 2.0", "Apache-2.0", true},
+		{"apache, version 2", "Apache-2.0", true},
+		{"ASL 2.0", "Apache-2.0", true},
+		{"GPLv2", "GPL-2.0-only", true},
+		{"GPL v2", "GPL-2.0-only", true},
+		{"GPLv2+", "GPL-2.0-or-later", true},
+		{"GPLv3", "GPL-3.0-only", true},
+		{"LGPLv2.1", "LGPL-2.1-only", true},
+		{"MPL 2.0", "MPL-2.0", true},
+
+		// expressions
+		{"MIT or apache-2.0", "MIT OR Apache-2.0", true},
+		{"(mit AND bsd-3-clause) OR gpl-2.0-only WITH classpath-exception-2.0", "(MIT AND BSD-3-Clause) OR GPL-2.0-only WITH Classpath-exception-2.0", true},
+		{"GPLv2 OR MIT", "GPL-2.0-only OR MIT", true},
+		{"MIT AND not-a-license", "MIT AND not-a-license", false},
+
+		// unknown licenses are kept as is
+		{"", "", false},
+		{"Some Custom License", "Some Custom License", false},
+		{"BSD", "BSD", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, mapped := Normalize(tt.value)
+			if got != tt.want || mapped != tt.mapped {
+				t.Errorf("Normalize(%q) = %q, %v, want %q, %v", tt.value, got, mapped, tt.want, tt.mapped)
+			}
+		})
+	}
+}
+
+func TestNameKey(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"The Apache License, Version 2.0", "apache license 2.0"},
+		{"Apache License v2.0", "apache license 2.0"},
+		{`BSD 3-Clause "New" or "Revised" License`, "bsd 3-clause new or revised license"},
+		{"  MIT   License ", "mit license"},
+	}
+
+	for _, tt := range tests {
+		if got := nameKey(tt.name); got != tt.want {
+			t.Errorf("nameKey(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLicenseAliases(t *testing.T) {
+	for alias, id := range licenseAliases {
+		l, ok := licenseList[id]
+		if !ok {
+			t.Errorf("alias %q maps to %s, which is not an spdx license", alias, id)
+			continue
+		}
+		if l.deprecated {
+			t.Errorf("alias %q maps to the deprecated %s", alias, id)
+		}
+		if got, _ := Normalize(alias); got != id {
+			t.Errorf("Normalize(%q) = %q, want %q", alias, got, id)
+		}
+	}
+}
@@ -45,6 +45,18 @@ func InitDebugLogger() {
 	logger = l.Sugar()
 }
 
+// InitQuietLogger only logs errors
+func InitQuietLogger() {
+	cfg := zap.NewProductionConfig()
+	cfg.Level = zap.NewAtomicLevelAt(zap.ErrorLevel)
+	l, _ := cfg.Build()
+	defer l.Sync()
+	if logger != nil {
+		panic("logger already initialized")
+	}
+	logger = l.Sugar()
+}
+
 func WithLogger(ctx context.Context) context.Context {
 	return context.WithValue(ctx, logKey{}, logger)
 }
@@ -0,0 +1,109 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package progress shows the progress of an assembly on a terminal. The
+// reporter is carried by the context like the logger, without one nothing is
+// shown.
+package progress
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+type progressKey struct{}
+
+// Reporter redraws a single line with the count of steps done in the current
+// stage. A nil Reporter reports nothing.
+type Reporter struct {
+	mu    sync.Mutex
+	w     io.Writer
+	stage string
+	done  int
+	total int
+}
+
+func New(w io.Writer) *Reporter {
+	return &Reporter{w: w}
+}
+
+// IsTerminal reports whether f is a terminal, progress is only shown on one
+// as the line is redrawn in place.
+func IsTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+func WithReporter(ctx context.Context, r *Reporter) context.Context {
+	return context.WithValue(ctx, progressKey{}, r)
+}
+
+func FromContext(ctx context.Context) *Reporter {
+	r, _ := ctx.Value(progressKey{}).(*Reporter)
+	return r
+}
+
+// Start begins a stage of total steps, e.g parsing the input sboms.
+func (r *Reporter) Start(stage string, total int) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.stage, r.done, r.total = stage, 0, total
+	r.draw("")
+}
+
+// Step marks a step of the stage done, item names it.
+func (r *Reporter) Step(item string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.done++
+	r.draw(item)
+}
+
+// Done ends the stage, its final count is kept on its own line.
+func (r *Reporter) Done() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.stage == "" {
+		return
+	}
+	r.draw("")
+	fmt.Fprintln(r.w)
+	r.stage = ""
+}
+
+func (r *Reporter) draw(item string) {
+	line := fmt.Sprintf("%s %d/%d", r.stage, r.done, r.total)
+	if item != "" {
+		line += " " + item
+	}
+	// return to the start of the line and clear it
+	fmt.Fprintf(r.w, "\r\033[K%s", line)
+}
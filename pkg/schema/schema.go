@@ -0,0 +1,178 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schema validates sboms against the CycloneDX and SPDX JSON schemas
+// bundled with sbomasm, so validation works offline.
+package schema
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// maxMessageLength is the longest enumeration message which is kept as is.
+const maxMessageLength = 160
+
+var (
+	//go:embed files
+	res embed.FS
+
+	// schemas maps a spec and version to the url of its schema.
+	schemas = map[string]string{
+		"cyclonedx:1.4": "http://cyclonedx.org/schema/bom-1.4.schema.json",
+		"cyclonedx:1.5": "http://cyclonedx.org/schema/bom-1.5.schema.json",
+		"cyclonedx:1.6": "http://cyclonedx.org/schema/bom-1.6.schema.json",
+		"spdx:2.3":      "http://spdx.org/rdf/terms/2.3",
+	}
+
+	// resources maps the url of every schema, including the ones referenced
+	// by the CycloneDX schemas, to its bundled file.
+	resources = map[string]string{
+		"http://cyclonedx.org/schema/bom-1.4.schema.json":  "files/bom-1.4.schema.json",
+		"http://cyclonedx.org/schema/bom-1.5.schema.json":  "files/bom-1.5.schema.json",
+		"http://cyclonedx.org/schema/bom-1.6.schema.json":  "files/bom-1.6.schema.json",
+		"http://cyclonedx.org/schema/spdx.schema.json":     "files/spdx.schema.json",
+		"http://cyclonedx.org/schema/jsf-0.82.schema.json": "files/jsf-0.82.schema.json",
+		"http://spdx.org/rdf/terms/2.3":                    "files/spdx-2.3.schema.json",
+	}
+)
+
+// Violation is a value of the sbom which does not match the schema.
+type Violation struct {
+	// Path is the json pointer of the value in the sbom
+	Path    string
+	Message string
+}
+
+func (v Violation) String() string {
+	p := v.Path
+	if p == "" {
+		p = "/"
+	}
+	return fmt.Sprintf("%s: %s", p, v.Message)
+}
+
+// Supported reports whether a schema is bundled for the spec version.
+func Supported(spec, version string) bool {
+	_, ok := schemas[spec+":"+version]
+	return ok
+}
+
+// Validate checks the json sbom against the schema of the spec version and
+// returns the violations found, sorted by path.
+func Validate(spec, version string, doc []byte) ([]Violation, error) {
+	url, ok := schemas[spec+":"+version]
+	if !ok {
+		return nil, fmt.Errorf("no schema for %s %s", spec, version)
+	}
+
+	c := jsonschema.NewCompiler()
+	c.LoadURL = loadURL
+
+	sch, err := c.Compile(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile the %s %s schema: %w", spec, version, err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("sbom is not valid json: %w", err)
+	}
+
+	err = sch.Validate(v)
+	if err == nil {
+		return nil, nil
+	}
+
+	ve, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return nil, err
+	}
+
+	violations := []Violation{}
+	collect(ve, &violations)
+
+	sort.SliceStable(violations, func(i, j int) bool {
+		return violations[i].Path < violations[j].Path
+	})
+	return violations, nil
+}
+
+// collect appends the leaves of the validation error tree, the inner nodes
+// only say that a sub schema failed. Of the alternatives of a oneOf or anyOf
+// only the one which matched deepest into the sbom is reported, it is the
+// most likely to be the intended one.
+func collect(ve *jsonschema.ValidationError, violations *[]Violation) {
+	if len(ve.Causes) == 0 {
+		*violations = append(*violations, Violation{Path: ve.InstanceLocation, Message: shorten(ve.Message)})
+		return
+	}
+
+	causes := ve.Causes
+	if strings.HasSuffix(ve.KeywordLocation, "/oneOf") || strings.HasSuffix(ve.KeywordLocation, "/anyOf") {
+		causes = []*jsonschema.ValidationError{lo.MaxBy(causes, func(a, b *jsonschema.ValidationError) bool {
+			return depth(a) > depth(b)
+		})}
+	}
+
+	for _, cause := range causes {
+		collect(cause, violations)
+	}
+}
+
+// depth is the deepest instance location of the leaves of the error tree.
+func depth(ve *jsonschema.ValidationError) int {
+	d := strings.Count(ve.InstanceLocation, "/")
+	for _, cause := range ve.Causes {
+		d = max(d, depth(cause))
+	}
+	return d
+}
+
+// shorten drops the list of allowed values of large enumerations, such as the
+// SPDX license ids.
+func shorten(msg string) string {
+	if strings.HasPrefix(msg, "value must be one of") && len(msg) > maxMessageLength {
+		return "value is not one of the allowed values"
+	}
+	return msg
+}
+
+// loadURL serves the bundled schemas, no schema is fetched from the network.
+func loadURL(url string) (io.ReadCloser, error) {
+	file, ok := resources[strings.TrimSuffix(url, "#")]
+	if !ok {
+		return nil, fmt.Errorf("schema %s is not bundled", url)
+	}
+
+	data, err := res.ReadFile(path.Clean(file))
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
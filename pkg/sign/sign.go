@@ -0,0 +1,268 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sign creates and checks detached JSON Web Signatures (RFC 7515
+// appendix F) over assembled sboms. The signature is written next to the sbom
+// with a .sig extension, its payload is left out so the sbom is signed as the
+// exact bytes of the file.
+package sign
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+)
+
+// Extension is appended to the path of an sbom to name its signature.
+const Extension = ".sig"
+
+// SignatureFile returns the path of the signature of the sbom at path.
+func SignatureFile(path string) string {
+	return path + Extension
+}
+
+type header struct {
+	Alg string `json:"alg"`
+}
+
+// Signer signs sboms with a private key.
+type Signer struct {
+	key crypto.Signer
+	alg string
+}
+
+// LoadSigner reads a PEM encoded private key, PKCS#8, PKCS#1 RSA or SEC 1 EC.
+// ECDSA P-256, P-384 and P-521, RSA and Ed25519 keys are supported.
+func LoadSigner(path string) (*Signer, error) {
+	key, err := readKey(path)
+	if err != nil {
+		return nil, err
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%s does not hold a private key", path)
+	}
+
+	alg, err := algorithm(signer.Public())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &Signer{key: signer, alg: alg}, nil
+}
+
+// Sign returns the compact detached JWS of payload, "<header>..<signature>".
+func (s *Signer) Sign(payload []byte) ([]byte, error) {
+	h, err := json.Marshal(header{Alg: s.alg})
+	if err != nil {
+		return nil, err
+	}
+	protected := base64.RawURLEncoding.EncodeToString(h)
+
+	sig, err := s.sign([]byte(protected + "." + base64.RawURLEncoding.EncodeToString(payload)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign: %w", err)
+	}
+	return []byte(protected + ".." + base64.RawURLEncoding.EncodeToString(sig)), nil
+}
+
+func (s *Signer) sign(input []byte) ([]byte, error) {
+	switch key := s.key.(type) {
+	case ed25519.PrivateKey:
+		return key.Sign(rand.Reader, input, crypto.Hash(0))
+	case *rsa.PrivateKey:
+		digest, hash := digest(s.alg, input)
+		return rsa.SignPKCS1v15(rand.Reader, key, hash, digest)
+	case *ecdsa.PrivateKey:
+		digest, _ := digest(s.alg, input)
+		r, ss, err := ecdsa.Sign(rand.Reader, key, digest)
+		if err != nil {
+			return nil, err
+		}
+		// JWS uses the fixed size concatenation of r and s, not ASN.1
+		size := (key.Curve.Params().BitSize + 7) / 8
+		sig := make([]byte, 2*size)
+		r.FillBytes(sig[:size])
+		ss.FillBytes(sig[size:])
+		return sig, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %T", s.key)
+}
+
+// Verify checks the detached JWS sig of payload with the key at keyPath, a
+// public key or the private key it was signed with.
+func Verify(keyPath string, payload, sig []byte) error {
+	key, err := readKey(keyPath)
+	if err != nil {
+		return err
+	}
+	if signer, ok := key.(crypto.Signer); ok {
+		key = signer.Public()
+	}
+
+	alg, err := algorithm(key)
+	if err != nil {
+		return fmt.Errorf("%s: %w", keyPath, err)
+	}
+
+	parts := strings.Split(strings.TrimSpace(string(sig)), ".")
+	if len(parts) != 3 {
+		return errors.New("signature is not a compact JWS")
+	}
+	if parts[1] != "" {
+		return errors.New("signature is not detached, it holds a payload")
+	}
+
+	h, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return fmt.Errorf("invalid signature header: %w", err)
+	}
+	var hdr header
+	if err := json.Unmarshal(h, &hdr); err != nil {
+		return fmt.Errorf("invalid signature header: %w", err)
+	}
+	if hdr.Alg != alg {
+		return fmt.Errorf("signature algorithm %s does not match the %s key", hdr.Alg, alg)
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	input := []byte(parts[0] + "." + base64.RawURLEncoding.EncodeToString(payload))
+	if !verify(key, alg, input, raw) {
+		return errors.New("signature does not match the sbom")
+	}
+	return nil
+}
+
+// VerifyFile checks the signature next to the sbom at path with the key at
+// keyPath.
+func VerifyFile(path, keyPath string) error {
+	payload, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	sig, err := os.ReadFile(SignatureFile(path))
+	if err != nil {
+		return fmt.Errorf("unable to read signature of %s: %w", path, err)
+	}
+	return Verify(keyPath, payload, sig)
+}
+
+func verify(key crypto.PublicKey, alg string, input, sig []byte) bool {
+	switch key := key.(type) {
+	case ed25519.PublicKey:
+		return ed25519.Verify(key, input, sig)
+	case *rsa.PublicKey:
+		digest, hash := digest(alg, input)
+		return rsa.VerifyPKCS1v15(key, hash, digest, sig) == nil
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if len(sig) != 2*size {
+			return false
+		}
+		digest, _ := digest(alg, input)
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		return ecdsa.Verify(key, digest, r, s)
+	}
+	return false
+}
+
+// algorithm returns the JWS algorithm signing with key.
+func algorithm(key crypto.PublicKey) (string, error) {
+	switch key := key.(type) {
+	case ed25519.PublicKey:
+		return "EdDSA", nil
+	case *rsa.PublicKey:
+		return "RS256", nil
+	case *ecdsa.PublicKey:
+		switch key.Curve.Params().BitSize {
+		case 256:
+			return "ES256", nil
+		case 384:
+			return "ES384", nil
+		case 521:
+			return "ES512", nil
+		}
+		return "", fmt.Errorf("unsupported curve %s", key.Curve.Params().Name)
+	}
+	return "", fmt.Errorf("unsupported key type %T", key)
+}
+
+func digest(alg string, input []byte) ([]byte, crypto.Hash) {
+	switch alg {
+	case "ES384":
+		d := sha512.Sum384(input)
+		return d[:], crypto.SHA384
+	case "ES512":
+		d := sha512.Sum512(input)
+		return d[:], crypto.SHA512
+	}
+	d := sha256.Sum256(input)
+	return d[:], crypto.SHA256
+}
+
+// readKey parses the first PEM block of the file at path as a private or
+// public key.
+func readKey(path string) (interface{}, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key: %w", err)
+	}
+
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s is not a PEM encoded key", path)
+	}
+
+	var key interface{}
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "ENCRYPTED PRIVATE KEY":
+		return nil, fmt.Errorf("%s is encrypted, decrypt the key first", path)
+	default:
+		return nil, fmt.Errorf("%s holds an unsupported PEM block %s", path, block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse key %s: %w", path, err)
+	}
+	return key, nil
+}
@@ -0,0 +1,308 @@
+// Copyright 2023 Interlynk.io
+//
+// SPDX-License-Identifier: Apache-2.0
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sign
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeKeys writes key as a PKCS#8 private key and its PKIX public key to the
+// test directory, returning both paths.
+func writeKeys(t *testing.T, name string, key crypto.Signer) (string, string) {
+	t.Helper()
+	dir := t.TempDir()
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	private := filepath.Join(dir, name+".pem")
+	if err := os.WriteFile(private, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	der, err = x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	public := filepath.Join(dir, name+".pub.pem")
+	if err := os.WriteFile(public, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return private, public
+}
+
+type testKey struct {
+	name string
+	alg  string
+	key  crypto.Signer
+}
+
+func testKeys(t *testing.T) []testKey {
+	t.Helper()
+
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return []testKey{
+		{name: "ed25519", alg: "EdDSA", key: edKey},
+		{name: "rsa", alg: "RS256", key: rsaKey},
+		{name: "ecdsa", alg: "ES256", key: ecKey},
+	}
+}
+
+// jwsParts splits a compact JWS and decodes its header.
+func jwsParts(t *testing.T, sig []byte) ([]string, header) {
+	t.Helper()
+
+	parts := strings.Split(string(sig), ".")
+	if len(parts) != 3 {
+		t.Fatalf("signature %s is not a compact JWS", sig)
+	}
+	h, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var hdr header
+	if err := json.Unmarshal(h, &hdr); err != nil {
+		t.Fatal(err)
+	}
+	return parts, hdr
+}
+
+func TestSignVerify(t *testing.T) {
+	payload := []byte(`{"bomFormat":"CycloneDX","specVersion":"1.6"}`)
+	keys := testKeys(t)
+
+	for _, k := range keys {
+		t.Run(k.name, func(t *testing.T) {
+			private, public := writeKeys(t, k.name, k.key)
+
+			signer, err := LoadSigner(private)
+			if err != nil {
+				t.Fatalf("LoadSigner: %v", err)
+			}
+			sig, err := signer.Sign(payload)
+			if err != nil {
+				t.Fatalf("Sign: %v", err)
+			}
+
+			parts, hdr := jwsParts(t, sig)
+			if hdr.Alg != k.alg {
+				t.Errorf("signature algorithm = %s, want %s", hdr.Alg, k.alg)
+			}
+			if parts[1] != "" {
+				t.Errorf("signature holds a payload %s, want it detached", parts[1])
+			}
+
+			t.Run("round trip", func(t *testing.T) {
+				if err := Verify(public, payload, sig); err != nil {
+					t.Errorf("Verify with the public key: %v", err)
+				}
+				if err := Verify(private, payload, sig); err != nil {
+					t.Errorf("Verify with the private key: %v", err)
+				}
+				// the signature is written with a trailing newline
+				if err := Verify(public, payload, append(sig, '\n')); err != nil {
+					t.Errorf("Verify with a trailing newline: %v", err)
+				}
+			})
+
+			t.Run("changed payload", func(t *testing.T) {
+				for _, i := range []int{0, len(payload) / 2, len(payload) - 1} {
+					changed := append([]byte{}, payload...)
+					changed[i] ^= 0x01
+					if err := Verify(public, changed, sig); err == nil {
+						t.Errorf("Verify accepted the payload changed at byte %d", i)
+					}
+				}
+				if err := Verify(public, append(payload, ' '), sig); err == nil {
+					t.Error("Verify accepted the payload with a byte appended")
+				}
+			})
+
+			t.Run("embedded payload", func(t *testing.T) {
+				embedded := parts[0] + "." + base64.RawURLEncoding.EncodeToString(payload) + "." + parts[2]
+				err := Verify(public, payload, []byte(embedded))
+				if err == nil || !strings.Contains(err.Error(), "not detached") {
+					t.Errorf("Verify of an embedded payload JWS = %v, want it rejected as not detached", err)
+				}
+			})
+
+			t.Run("algorithm mismatch", func(t *testing.T) {
+				for _, alg := range []string{"none", "HS256", "RS256", "ES256", "EdDSA"} {
+					if alg == k.alg {
+						continue
+					}
+					h, _ := json.Marshal(header{Alg: alg})
+					forged := base64.RawURLEncoding.EncodeToString(h) + ".." + parts[2]
+					if err := Verify(public, payload, []byte(forged)); err == nil {
+						t.Errorf("Verify accepted a %s header for the %s key", alg, k.alg)
+					}
+				}
+			})
+
+			t.Run("key mismatch", func(t *testing.T) {
+				for _, other := range keys {
+					if other.name == k.name {
+						continue
+					}
+					_, otherPublic := writeKeys(t, other.name, other.key)
+					if err := Verify(otherPublic, payload, sig); err == nil {
+						t.Errorf("Verify accepted the %s signature with the %s key", k.alg, other.alg)
+					}
+				}
+			})
+		})
+	}
+}
+
+func TestSignES256Encoding(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	private, public := writeKeys(t, "ecdsa", key)
+
+	signer, err := LoadSigner(private)
+	if err != nil {
+		t.Fatal(err)
+	}
+	payload := []byte("sbom")
+	sig, err := signer.Sign(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts, _ := jwsParts(t, sig)
+	raw, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// RFC 7518 section 3.4, the 32 byte big endian R followed by S
+	if len(raw) != 64 {
+		t.Fatalf("ES256 signature is %d bytes, want 64", len(raw))
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + base64.RawURLEncoding.EncodeToString(payload)))
+	r := new(big.Int).SetBytes(raw[:32])
+	s := new(big.Int).SetBytes(raw[32:])
+	if !ecdsa.Verify(&key.PublicKey, digest[:], r, s) {
+		t.Error("R||S of the signature does not verify the signing input")
+	}
+
+	// an ASN.1 DER signature, as crypto.Signer returns it, is not valid JWS
+	der, err := ecdsa.SignASN1(rand.Reader, key, digest[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	asn1 := parts[0] + ".." + base64.RawURLEncoding.EncodeToString(der)
+	if err := Verify(public, payload, []byte(asn1)); err == nil {
+		t.Error("Verify accepted an ASN.1 encoded ES256 signature")
+	}
+
+	// R or S with a leading zero byte, about one in 128 signatures, is still
+	// written as 32 bytes
+	for i := 0; i < 4096; i++ {
+		payload := []byte(fmt.Sprintf("sbom %d", i))
+		sig, err := signer.Sign(payload)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts, _ := jwsParts(t, sig)
+		raw, err := base64.RawURLEncoding.DecodeString(parts[2])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(raw) == 64 && raw[0] != 0 && raw[32] != 0 {
+			continue
+		}
+		if len(raw) != 64 {
+			t.Fatalf("ES256 signature with a short R or S is %d bytes, want 64", len(raw))
+		}
+		if err := Verify(public, payload, sig); err != nil {
+			t.Fatalf("Verify of a signature with a short R or S: %v", err)
+		}
+		return
+	}
+	t.Fatal("no signature with a short R or S was made")
+}
+
+func TestVerifyFile(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	private, public := writeKeys(t, "ecdsa", key)
+	signer, err := LoadSigner(private)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(t.TempDir(), "sbom.cdx.json")
+	payload := []byte(`{"bomFormat":"CycloneDX"}`)
+	if err := os.WriteFile(path, payload, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := VerifyFile(path, public); err == nil {
+		t.Error("VerifyFile accepted an sbom without a signature")
+	}
+
+	sig, err := signer.Sign(payload)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(SignatureFile(path), append(sig, '\n'), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyFile(path, public); err != nil {
+		t.Errorf("VerifyFile: %v", err)
+	}
+
+	if err := os.WriteFile(path, append(payload, '\n'), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := VerifyFile(path, public); err == nil {
+		t.Error("VerifyFile accepted a changed sbom")
+	}
+}